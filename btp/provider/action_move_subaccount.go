@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MoveSubaccountAction struct {
+	cli *btpcli.ClientFacade
+}
+
+type MoveSubaccountActionModel struct {
+	SubaccountIds     types.Set    `tfsdk:"subaccount_ids"`
+	TargetDirectoryId types.String `tfsdk:"target_directory_id"`
+}
+
+var _ action.Action = &MoveSubaccountAction{}
+
+func NewMoveSubaccountAction() action.Action {
+	return &MoveSubaccountAction{}
+}
+
+func (a *MoveSubaccountAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_move_subaccount", req.ProviderTypeName)
+}
+
+func (a *MoveSubaccountAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Moves one or more subaccounts to a directory or to the root of the global account.
+
+__Notes:__
+- This action can be used to move subaccounts via Terraform in analogy to the btp CLI command "btp move accounts/subaccount".
+- Be aware that the execution of the action does not result in any changes to the Terraform state. If the moved subaccounts are managed via the resource ["btp_subaccount"](https://registry.terraform.io/providers/SAP/btp/latest/docs/resources/subaccount), adjust the attribute ` + "`parent_id`" + ` and re-import the subaccounts as described in the guide on moving subaccounts.
+- Subaccounts that are already located in the target are skipped.
+
+__Tip:__
+You must be assigned to the global account admin role or to the directory admin role of the source and target directories.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/change-subaccount-details>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the subaccounts to move.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(uuidvalidator.ValidUUID()),
+				},
+			},
+			"target_directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory to which the subaccounts are moved. If not set, the subaccounts are moved to the root of the global account.",
+				Optional:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+		},
+	}
+}
+
+func (a *MoveSubaccountAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cli = cli
+}
+
+func (a *MoveSubaccountAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data MoveSubaccountActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subaccountIds []string
+	resp.Diagnostics.Append(data.SubaccountIds.ElementsAs(ctx, &subaccountIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetDirectoryId := data.TargetDirectoryId.ValueString()
+
+	// Do validations: ValidateConfig cannot be used as the CLI facade is not yet initialized
+	// Validate that the target directory exists, so that no subaccount is moved if the target is wrong
+	if len(targetDirectoryId) > 0 {
+		if _, _, err := a.cli.Accounts.Directory.Get(ctx, targetDirectoryId, ""); err != nil {
+			resp.Diagnostics.AddError("API Error Reading Directory", fmt.Sprintf("%s", err))
+			return
+		}
+	}
+
+	// Validate that all subaccounts exist before moving the first one
+	subaccounts := make([]cis.SubaccountResponseObject, 0, len(subaccountIds))
+	for _, subaccountId := range subaccountIds {
+		cliRes, _, err := a.cli.Accounts.Subaccount.Get(ctx, subaccountId)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Reading Subaccount", fmt.Sprintf("%s", err))
+			return
+		}
+
+		subaccounts = append(subaccounts, cliRes)
+	}
+
+	for i, subaccount := range subaccounts {
+		if isSubaccountInTarget(subaccount, targetDirectoryId) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("[%d/%d] Subaccount %s is already located in the target, skipping.", i+1, len(subaccounts), subaccount.Guid),
+			})
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%d/%d] Moving subaccount %s...", i+1, len(subaccounts), subaccount.Guid),
+		})
+
+		if !a.moveSubaccount(ctx, subaccount, targetDirectoryId, resp) {
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Subaccounts moved successfully.",
+	})
+}
+
+// moveSubaccount moves a single subaccount and waits until the move has finished
+func (a *MoveSubaccountAction) moveSubaccount(ctx context.Context, subaccount cis.SubaccountResponseObject, targetDirectoryId string, resp *action.InvokeResponse) bool {
+	parentId, isParentGlobalAccount, err := determineParentIdForAuthorization(a.cli, ctx, subaccount.ParentGUID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error determining parent features for authorization", fmt.Sprintf("%s", err))
+		return false
+	}
+
+	var sourceDirectoryId string
+
+	if !isParentGlobalAccount {
+		//if the parent is a managed directory, the directoryId must be set to make sure the right authorizations are validated
+		sourceDirectoryId = parentId
+	}
+
+	_, _, err = a.cli.Accounts.Subaccount.Move(ctx, subaccount.Guid, sourceDirectoryId, targetDirectoryId)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Moving Subaccount", fmt.Sprintf("%s", err))
+		return false
+	}
+
+	moveStateConf := &tfutils.StateChangeConf{
+		Pending: []string{cis.StateUpdating, cis.StateStarted},
+		Target:  []string{cis.StateOK},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := a.cli.Accounts.Subaccount.Get(ctx, subaccount.Guid)
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    tfutils.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	moveRes, err := moveStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Moving Subaccount", fmt.Sprintf("%s", err))
+		return false
+	}
+
+	if !isSubaccountInTarget(moveRes.(cis.SubaccountResponseObject), targetDirectoryId) {
+		resp.Diagnostics.AddError(
+			"API Error Moving Subaccount",
+			fmt.Sprintf("subaccount with ID %s is not located in the target after the move completed", subaccount.Guid),
+		)
+		return false
+	}
+
+	return true
+}
+
+// isSubaccountInTarget checks whether the subaccount is located in the target directory or, if no
+// target directory is given, in the root of the global account
+func isSubaccountInTarget(subaccount cis.SubaccountResponseObject, targetDirectoryId string) bool {
+	if len(targetDirectoryId) > 0 {
+		return subaccount.ParentGUID == targetDirectoryId
+	}
+
+	return subaccount.ParentGUID == subaccount.GlobalAccountGUID
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis"
+)
+
+func TestActionMoveSubaccount(t *testing.T) {
+	t.Parallel()
+	t.Run("error path - subaccount_ids not valid UUIDs", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclActionMoveSubaccount(`["this-is-not-a-uuid"]`, "5357bda0-8651-4eab-a69d-12d282bc3247"),
+					ExpectError: regexp.MustCompile(`value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount_ids empty", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclActionMoveSubaccount(`[]`, "5357bda0-8651-4eab-a69d-12d282bc3247"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_ids set must contain at least 1 elements`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - target_directory_id not a valid UUID", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclActionMoveSubaccount(`["6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"]`, "this-is-not-a-uuid"),
+					ExpectError: regexp.MustCompile(`Attribute target_directory_id value must be a valid UUID, got:\s+this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+}
+
+func TestIsSubaccountInTarget(t *testing.T) {
+	subaccount := cis.SubaccountResponseObject{
+		GlobalAccountGUID: "795b53bb-a3f0-4769-adf0-26173282a975",
+		ParentGUID:        "5357bda0-8651-4eab-a69d-12d282bc3247",
+	}
+
+	assert.True(t, isSubaccountInTarget(subaccount, "5357bda0-8651-4eab-a69d-12d282bc3247"))
+	assert.False(t, isSubaccountInTarget(subaccount, "1b2c3d4e-8651-4eab-a69d-12d282bc3247"))
+	assert.False(t, isSubaccountInTarget(subaccount, ""))
+
+	subaccount.ParentGUID = subaccount.GlobalAccountGUID
+
+	assert.True(t, isSubaccountInTarget(subaccount, ""))
+	assert.False(t, isSubaccountInTarget(subaccount, "5357bda0-8651-4eab-a69d-12d282bc3247"))
+}
+
+/*
+IMPORTANT: Using a lifecycle.action_trigger block with config mode is currently the recommended way to test an action.
+See: https://developer.hashicorp.com/terraform/plugin/framework/actions/testing
+*/
+func hclActionMoveSubaccount(subaccountIds string, targetDirectoryId string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.btp_move_subaccount.test]
+    }
+  }
+}
+
+action "btp_move_subaccount" "test" {
+  config {
+    subaccount_ids      = %s
+    target_directory_id = "%s"
+  }
+}`, subaccountIds, targetDirectoryId)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceDirectoryTrustConfiguration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - directory_id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `data "btp_directory_trust_configuration" "uut" { origin = "sap.custom-platform" }`,
+					ExpectError: regexp.MustCompile(`The argument "directory_id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - origin mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `data "btp_directory_trust_configuration" "uut" { directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d" }`,
+					ExpectError: regexp.MustCompile(`The argument "origin" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+}
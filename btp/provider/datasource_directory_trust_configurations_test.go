@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceDirectoryTrustConfigurations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - directory_id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `data "btp_directory_trust_configurations" "uut" {}`,
+					ExpectError: regexp.MustCompile(`The argument "directory_id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - directory_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `data "btp_directory_trust_configurations" "uut" { directory_id = "this-is-not-a-uuid" }`,
+					ExpectError: regexp.MustCompile(`Attribute directory_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+}
@@ -121,7 +121,7 @@ You must be assigned to the admin or viewer role of the subaccount.`,
 }
 
 func (ds *subaccountServiceBindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data subaccountServiceBindingType
+	var data subaccountServiceBindingDataSourceType
 
 	diags := req.Config.Get(ctx, &data)
 
@@ -146,7 +146,7 @@ func (ds *subaccountServiceBindingDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	data, diags = subaccountServiceBindingValueFrom(ctx, cliRes)
+	data, diags = subaccountServiceBindingDataSourceValueFrom(ctx, cliRes)
 	data.Parameters = jsontypes.NewNormalizedNull() // the API doesn't return parameters for already created instances
 	resp.Diagnostics.Append(diags...)
 
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+type DirectoryApiCredentialEphemeralResource struct {
+	cli *btpcli.ClientFacade
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &DirectoryApiCredentialEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &DirectoryApiCredentialEphemeralResource{}
+
+func NewDirectoryApiCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &DirectoryApiCredentialEphemeralResource{}
+}
+
+func (e *DirectoryApiCredentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_directory_api_credential", req.ProviderTypeName)
+}
+
+func (e *DirectoryApiCredentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creates a short-lived API credential at the Directory level for the duration of a Terraform run. The credential is deleted once Terraform no longer needs it and is never persisted in the Terraform state.
+With the client ID and client secret, or certificate, you can request an access token for the REST APIs of the SAP Authorization and Trust Management service (XSUAA) in the targeted directory.
+
+__Tip:__
+You must be assigned to the directory admin role.
+
+__Note:__
+Ephemeral resources are available as of Terraform version 1.10. Terraform opens ephemeral resources during plan as well as during apply, so each run creates a new API credential in both phases.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/managing-api-credentials-for-calling-rest-apis-of-sap-authorization-and-trust-management-service>`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name for the API credential.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z\d-]+$`), "can contain only alphanumberic values and dashes."),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "A unique ID associated with the API credential.",
+				Computed:            true,
+			},
+			"credential_type": schema.StringAttribute{
+				MarkdownDescription: "The supported credential types are Secrets (Default) or Certificates.",
+				Computed:            true,
+			},
+			"certificate_passed": schema.StringAttribute{
+				MarkdownDescription: "If the user prefers to use a certificate, they must provide the certificate value in PEM format \"----BEGIN CERTIFICATE-----...-----END CERTIFICATE-----\".",
+				Optional:            true,
+			},
+			"certificate_received": schema.StringAttribute{
+				MarkdownDescription: "The certificate that is computed based on the one passed by the user.",
+				Computed:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "If the certificate is omitted, then a unique secret is generated for the API credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "RSA key generated if the API credential is created with a certificate.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Access restriction placed on the API credential. If set to true, the credential has only read-only access.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to be used to fetch the access token to make use of the XSUAA REST APIs.",
+				Computed:            true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to be used to make the API calls.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *DirectoryApiCredentialEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.cli = cli
+}
+
+func (e *DirectoryApiCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config directoryApiCredentialType
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := e.cli.Security.ApiCredential.CreateByDirectoryorSubaccount(ctx, &btpcli.ApiCredentialInput{
+		Directory:   config.DirectoryId.ValueString(),
+		Name:        config.Name.ValueString(),
+		Certificate: config.CertificatePassed.ValueString(),
+		ReadOnly:    config.ReadOnly.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Ephemeral Api Credential (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	data, diags := directoryApiCredentialFromValue(ctx, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	data.DirectoryId = config.DirectoryId
+	data.CertificatePassed = config.CertificatePassed
+
+	resp.Diagnostics.Append(setApiCredentialPrivateData(ctx, resp.Private, apiCredentialPrivateData{
+		Name:      cliRes.Name,
+		Directory: config.DirectoryId.ValueString(),
+	})...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		// Close is not called for a failed Open, so the created credential is deleted right away
+		_, _, err = e.cli.Security.ApiCredential.DeleteByDirectoryorSubaccount(ctx, &btpcli.ApiCredentialInput{
+			Directory: config.DirectoryId.ValueString(),
+			Name:      cliRes.Name,
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Deleting Ephemeral Api Credential (Directory)", fmt.Sprintf("%s", err))
+		}
+	}
+}
+
+func (e *DirectoryApiCredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := getApiCredentialPrivateData(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	_, _, err := e.cli.Security.ApiCredential.DeleteByDirectoryorSubaccount(ctx, &btpcli.ApiCredentialInput{
+		Directory: privateData.Directory,
+		Name:      privateData.Name,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Ephemeral Api Credential (Directory)", fmt.Sprintf("%s", err))
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralResourceGlobalaccountApiCredential(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - globalaccount id is computed", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_globalaccount_api_credential" "uut" {
+	globalaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+}`,
+					ExpectError: regexp.MustCompile(`Can.t configure a value for "globalaccount_id"`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid name", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_globalaccount_api_credential" "uut" {
+	name = "globalaccount api credential"
+}`,
+					ExpectError: regexp.MustCompile(`can contain only alphanumberic values and dashes`),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralResourceSubaccountApiCredential(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - subaccount id is mandatory", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_subaccount_api_credential" "uut" {
+	name = "subaccount-api-credential"
+}`,
+					ExpectError: regexp.MustCompile(`The argument "subaccount_id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid name", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_subaccount_api_credential" "uut" {
+	subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	name          = "subaccount api credential"
+}`,
+					ExpectError: regexp.MustCompile(`can contain only alphanumberic values and dashes`),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+type SubaccountServiceBindingCredentialsEphemeralResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type SubaccountServiceBindingCredentialsEphemeralResourceModel struct {
+	SubaccountId      types.String `tfsdk:"subaccount_id"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ServiceInstanceId types.String `tfsdk:"service_instance_id"`
+	Ready             types.Bool   `tfsdk:"ready"`
+	Credentials       types.String `tfsdk:"credentials"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SubaccountServiceBindingCredentialsEphemeralResource{}
+
+func NewSubaccountServiceBindingCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &SubaccountServiceBindingCredentialsEphemeralResource{}
+}
+
+func (e *SubaccountServiceBindingCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_binding_credentials", req.ProviderTypeName)
+}
+
+func (e *SubaccountServiceBindingCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Fetches the credentials of a service binding without persisting them in the Terraform state. The credentials can be passed on to other providers or to write-only attributes.
+
+__Tip:__
+You must be assigned to the admin or viewer role of the subaccount.
+
+__Note:__
+Ephemeral resources are available as of Terraform version 1.10.`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service binding.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service binding.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service_instance_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service instance associated with the binding.",
+				Computed:            true,
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the service binding is ready.",
+				Computed:            true,
+			},
+			"credentials": schema.StringAttribute{
+				MarkdownDescription: "The credentials to access the binding as a JSON object.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *SubaccountServiceBindingCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.cli = cli
+}
+
+func (e *SubaccountServiceBindingCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SubaccountServiceBindingCredentialsEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cliRes servicemanager.ServiceBindingResponseObject
+	var err error
+
+	if !data.Id.IsNull() {
+		cliRes, _, err = e.cli.Services.Binding.GetById(ctx, data.SubaccountId.ValueString(), data.Id.ValueString())
+	} else if !data.Name.IsNull() {
+		cliRes, _, err = e.cli.Services.Binding.GetByName(ctx, data.SubaccountId.ValueString(), data.Name.ValueString())
+	} else {
+		err = fmt.Errorf("neither binding ID, nor binding Name have been provided")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Service Binding Credentials (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	if !cliRes.Ready {
+		resp.Diagnostics.AddWarning("Service Binding Not Ready", fmt.Sprintf("The service binding %s is not ready yet. The credentials might be incomplete.", cliRes.Id))
+	}
+
+	data.Id = types.StringValue(cliRes.Id)
+	data.Name = types.StringValue(cliRes.Name)
+	data.ServiceInstanceId = types.StringValue(cliRes.ServiceInstanceId)
+	data.Ready = types.BoolValue(cliRes.Ready)
+	data.Credentials = types.StringValue(string(cliRes.Credentials))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
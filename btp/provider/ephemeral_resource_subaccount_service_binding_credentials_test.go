@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralResourceSubaccountServiceBindingCredentials(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - id and name are mutually exclusive", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclEphemeralResourceSubaccountServiceBindingCredentialsByIdAndName("uut", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "my-binding"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount id not a valid UUID", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclEphemeralResourceSubaccountServiceBindingCredentialsByName("uut", "this-is-not-a-uuid", "my-binding"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - subaccount id is mandatory", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+ephemeral "btp_subaccount_service_binding_credentials" "uut" {
+	name = "my-binding"
+}`,
+					ExpectError: regexp.MustCompile(`The argument "subaccount_id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+}
+
+func hclEphemeralResourceSubaccountServiceBindingCredentialsByName(resourceName string, subaccountId string, bindingName string) string {
+	return fmt.Sprintf(`
+ephemeral "btp_subaccount_service_binding_credentials" "%s" {
+	subaccount_id = "%s"
+	name          = "%s"
+}`, resourceName, subaccountId, bindingName)
+}
+
+func hclEphemeralResourceSubaccountServiceBindingCredentialsByIdAndName(resourceName string, subaccountId string, bindingId string, bindingName string) string {
+	return fmt.Sprintf(`
+ephemeral "btp_subaccount_service_binding_credentials" "%s" {
+	subaccount_id = "%s"
+	id            = "%s"
+	name          = "%s"
+}`, resourceName, subaccountId, bindingId, bindingName)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildLabelsFilterFunction struct{}
+
+var _ function.Function = &BuildLabelsFilterFunction{}
+
+func NewBuildLabelsFilterFunction() function.Function {
+	return &BuildLabelsFilterFunction{}
+}
+
+func (f *BuildLabelsFilterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_labels_filter"
+}
+
+func (f *BuildLabelsFilterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "build_labels_filter",
+		Description: "Builds a label query that can be used as labels_filter from a map of label keys to values. In the service_manager syntax a key with one value results in an eq expression, a key with multiple values in an in (...) expression. Single quotes in the values are escaped. The cis syntax results in a single key=value expression.",
+		MarkdownDescription: "Builds a label query that can be used as `labels_filter` from a map of label keys to values.\n\n" +
+			"The syntax `service_manager` is used by the data sources and list resources of the service manager, e.g. `btp_subaccount_service_instances`. A key with one value results in an `eq` expression, a key with multiple values in an `in (...)` expression. " +
+			"The expressions are sorted by key and joined with `and`, single quotes in the values are escaped.\n\n" +
+			"The syntax `cis` is used by `btp_subaccounts`. It results in a single `key=value` expression, so exactly one key with exactly one value must be given.",
+
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "labels",
+				Description:         "Map of label keys to the list of values to filter for",
+				MarkdownDescription: "Map of label keys to the list of values to filter for",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+			function.StringParameter{
+				Name:                "syntax",
+				Description:         "Syntax of the label query, either service_manager or cis",
+				MarkdownDescription: "Syntax of the label query, either `service_manager` or `cis`",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(LabelsFilterSyntaxServiceManager.String(), LabelsFilterSyntaxCis.String()),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildLabelsFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var labels map[string][]string
+	var syntax string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &labels, &syntax))
+
+	if resp.Error != nil {
+		return
+	}
+
+	filter, err := BuildLabelsFilter(labels, LabelsFilterSyntax(syntax))
+
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, filter))
+}
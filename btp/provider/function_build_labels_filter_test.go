@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionBuildLabelsFilter(t *testing.T) {
+
+	t.Parallel()
+	// Test happy case only, the error handling is mostly covered in helper_labels_filter_test.go
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: getProviders(nil),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFunctionBuildLabelsFilter(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("labels_filter", knownvalue.StringExact("landscape eq 'production' and team in ('a','b')")),
+					statecheck.ExpectKnownOutputValue("cis_labels_filter", knownvalue.StringExact("my-label=my-value")),
+				},
+			},
+		},
+	})
+
+}
+
+func hclFunctionBuildLabelsFilter() string {
+	return `output "labels_filter" {
+		value = provider::btp::build_labels_filter({ landscape = ["production"], team = ["a", "b"] }, "service_manager")
+  }
+  output "cis_labels_filter" {
+		value = provider::btp::build_labels_filter({ my-label = ["my-value"] }, "cis")
+  }`
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParseLabelsFunction struct{}
+
+var _ function.Function = &ParseLabelsFunction{}
+
+type parseLabelsResultType struct {
+	CfApiUrl          types.String `tfsdk:"cf_api_url"`
+	CfOrgId           types.String `tfsdk:"cf_org_id"`
+	CfOrgName         types.String `tfsdk:"cf_org_name"`
+	KymaApiServerUrl  types.String `tfsdk:"kyma_api_server_url"`
+	KymaKubeconfigUrl types.String `tfsdk:"kyma_kubeconfig_url"`
+}
+
+func NewParseLabelsFunction() function.Function {
+	return &ParseLabelsFunction{}
+}
+
+func (f *ParseLabelsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_labels"
+}
+
+func (f *ParseLabelsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "parse_labels",
+		Description:         "Parses the label string of a Cloud Foundry or Kyma environment instance and returns all supported values at once. Values that are not contained in the label string are null.",
+		MarkdownDescription: "Parses the label string of a Cloud Foundry or Kyma environment instance and returns all supported values at once. Values that are not contained in the label string are `null`.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "labels",
+				Description:         "Label string of a Cloud Foundry or Kyma environment instance",
+				MarkdownDescription: "Label string of a Cloud Foundry or Kyma environment instance",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"cf_api_url":          types.StringType,
+				"cf_org_id":           types.StringType,
+				"cf_org_name":         types.StringType,
+				"kyma_api_server_url": types.StringType,
+				"kyma_kubeconfig_url": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseLabelsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var label string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &label))
+
+	if resp.Error != nil {
+		return
+	}
+
+	values, err := ParseEnvironmentLabels(label)
+
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := parseLabelsResultType{
+		CfApiUrl:          parsedLabelValue(values, EnvironmentLabelKeyCfApiUrl),
+		CfOrgId:           parsedLabelValue(values, EnvironmentLabelKeyCfOrgId),
+		CfOrgName:         parsedLabelValue(values, EnvironmentLabelKeyCfOrgName),
+		KymaApiServerUrl:  parsedLabelValue(values, EnvironmentLabelKeyKymaApiServerUrl),
+		KymaKubeconfigUrl: parsedLabelValue(values, EnvironmentLabelKeyKymaKubeconfigUrl),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func parsedLabelValue(values map[EnvironmentLabelKey]string, key EnvironmentLabelKey) types.String {
+	value, ok := values[key]
+	if !ok {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionParseLabels(t *testing.T) {
+
+	t.Parallel()
+	// Test happy case only, the error handling is mostly covered in helper_extract_environment_label_test.go
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: getProviders(nil),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFunctionParseLabels(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("labels", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"cf_api_url":          knownvalue.StringExact("https://api.cf.example.com"),
+						"cf_org_id":           knownvalue.StringExact("8d818824-394a-4bae-9088-7a3c8ce93e57"),
+						"cf_org_name":         knownvalue.StringExact("cf-terraform-test"),
+						"kyma_api_server_url": knownvalue.Null(),
+						"kyma_kubeconfig_url": knownvalue.Null(),
+					})),
+				},
+			},
+		},
+	})
+
+}
+
+func hclFunctionParseLabels() string {
+	return `output "labels" {
+		value = provider::btp::parse_labels("{\"API Endpoint\":\"https://api.cf.example.com\",\"Org Name\":\"cf-terraform-test\",\"Org ID\":\"8d818824-394a-4bae-9088-7a3c8ce93e57\",\"Org Memory Limit\":\"0MB\"}")
+  }`
+}
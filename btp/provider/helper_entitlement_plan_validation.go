@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis_entitlements"
+)
+
+const (
+	entitlementSourceGlobalAccount = "global account"
+	entitlementSourceDirectory     = "directory"
+)
+
+// listEntitlementsOfSubaccountParent fetches the entitlements the subaccount draws its quota from. This is the global account
+// unless the subaccount is part of a directory that manages its entitlements (feature "ENTITLEMENTS"), analogous to the
+// assignment of the entitlement. The returned source names the owner of the entitlements for the validation messages.
+// For a subaccount that is not created yet the entitlements of the global account are returned.
+func listEntitlementsOfSubaccountParent(ctx context.Context, cli *btpcli.ClientFacade, subaccountId types.String) (entitlements cis_entitlements.EntitledAndAssignedServicesResponseObject, source string, err error) {
+	if !subaccountId.IsUnknown() {
+		subaccountData, _, err := cli.Accounts.Subaccount.Get(ctx, subaccountId.ValueString())
+		if err != nil {
+			return entitlements, "", err
+		}
+
+		parentId, isParentGlobalAccount, err := determineParentIdForEntitlement(cli, ctx, subaccountData.ParentGUID)
+		if err != nil {
+			return entitlements, "", err
+		}
+
+		if !isParentGlobalAccount {
+			entitlements, _, err = cli.Accounts.Entitlement.ListByDirectory(ctx, parentId)
+			return entitlements, entitlementSourceDirectory, err
+		}
+	}
+
+	entitlements, _, err = cli.Accounts.Entitlement.ListByGlobalAccount(ctx)
+	return entitlements, entitlementSourceGlobalAccount, err
+}
+
+// validateEntitlementPlan checks whether the service plan is entitled to the source (global account or directory) and whether the
+// remaining quota of the source covers the additionally requested amount. Unknown service plans are reported as errors, a missing quota as warning.
+func validateEntitlementPlan(entitlements cis_entitlements.EntitledAndAssignedServicesResponseObject, source string, serviceName types.String, planName types.String, planUniqueIdentifier types.String, amount types.Int64, currentAmount int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var service *cis_entitlements.EntitledServicesResponseObject
+	for i := range entitlements.EntitledServices {
+		if entitlements.EntitledServices[i].Name == serviceName.ValueString() {
+			service = &entitlements.EntitledServices[i]
+			break
+		}
+	}
+
+	if service == nil {
+		diags.AddAttributeError(
+			path.Root("service_name"),
+			"Unknown Service",
+			fmt.Sprintf("The service %q is not entitled to the %s.", serviceName.ValueString(), source),
+		)
+		return diags
+	}
+
+	var servicePlan *cis_entitlements.ServicePlanResponseObject
+	var availablePlans []string
+	for i, plan := range service.ServicePlans {
+		availablePlans = append(availablePlans, plan.Name)
+
+		if plan.Name != planName.ValueString() {
+			continue
+		}
+
+		// The unique identifier is only needed to differentiate between identical plans with different pricing
+		if !planUniqueIdentifier.IsNull() && !planUniqueIdentifier.IsUnknown() && plan.UniqueIdentifier != planUniqueIdentifier.ValueString() {
+			continue
+		}
+
+		servicePlan = &service.ServicePlans[i]
+		break
+	}
+
+	if servicePlan == nil {
+		diags.AddAttributeError(
+			path.Root("plan_name"),
+			"Unknown Service Plan",
+			fmt.Sprintf("The plan %q of service %q is not entitled to the %s. Available plans: %s", planName.ValueString(), serviceName.ValueString(), source, strings.Join(availablePlans, ", ")),
+		)
+		return diags
+	}
+
+	if amount.IsNull() || amount.IsUnknown() || servicePlan.Unlimited {
+		return diags
+	}
+
+	// Categories that allow enabling/disabling only do not consume any quota
+	if servicePlan.Category == "ELASTIC_SERVICE" || servicePlan.Category == "ELASTIC_LIMITED" || servicePlan.Category == "APPLICATION" {
+		return diags
+	}
+
+	// The amount that is already assigned does not reduce the remaining quota any further
+	requestedAmount := amount.ValueInt64() - currentAmount
+	if requestedAmount > 0 && float64(requestedAmount) > servicePlan.RemainingAmount {
+		diags.AddAttributeWarning(
+			path.Root("amount"),
+			"Insufficient Quota",
+			fmt.Sprintf("The requested amount exceeds the remaining quota of plan %q of service %q in the %s. Requested additionally: %d, remaining: %v. The assignment might fail during apply.", planName.ValueString(), serviceName.ValueString(), source, requestedAmount, servicePlan.RemainingAmount),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis_entitlements"
+)
+
+func TestValidateEntitlementPlan(t *testing.T) {
+	entitlements := cis_entitlements.EntitledAndAssignedServicesResponseObject{
+		EntitledServices: []cis_entitlements.EntitledServicesResponseObject{
+			{
+				Name: "hana-cloud",
+				ServicePlans: []cis_entitlements.ServicePlanResponseObject{
+					{Name: "hana", Category: "SERVICE", RemainingAmount: 3},
+					{Name: "relational-data-lake", Category: "SERVICE", Unlimited: true},
+				},
+			},
+			{
+				Name: "alert-notification",
+				ServicePlans: []cis_entitlements.ServicePlanResponseObject{
+					{Name: "standard", Category: "ELASTIC_SERVICE"},
+				},
+			},
+		},
+	}
+
+	t.Run("unknown service", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-clod"), types.StringValue("hana"), types.StringNull(), types.Int64Value(1), 0)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Unknown Service", diags.Errors()[0].Summary())
+	})
+
+	t.Run("unknown plan", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hanna"), types.StringNull(), types.Int64Value(1), 0)
+
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Unknown Service Plan", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "hana, relational-data-lake")
+	})
+
+	t.Run("unknown plan unique identifier", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringValue("hana-other-pricing"), types.Int64Value(1), 0)
+
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("amount within remaining quota", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringUnknown(), types.Int64Value(3), 0)
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("amount exceeds remaining quota", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringNull(), types.Int64Value(4), 0)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, 1, diags.WarningsCount())
+		assert.Equal(t, "Insufficient Quota", diags.Warnings()[0].Summary())
+	})
+
+	t.Run("amount exceeds remaining quota of directory", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceDirectory, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringNull(), types.Int64Value(4), 0)
+
+		assert.Equal(t, 1, diags.WarningsCount())
+		assert.Contains(t, diags.Warnings()[0].Detail(), "in the directory")
+	})
+
+	t.Run("already assigned amount is considered", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringNull(), types.Int64Value(5), 2)
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("unlimited plan", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("relational-data-lake"), types.StringNull(), types.Int64Value(100), 0)
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("elastic plan", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("alert-notification"), types.StringValue("standard"), types.StringNull(), types.Int64Value(100), 0)
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("unknown amount", func(t *testing.T) {
+		diags := validateEntitlementPlan(entitlements, entitlementSourceGlobalAccount, types.StringValue("hana-cloud"), types.StringValue("hana"), types.StringNull(), types.Int64Unknown(), 0)
+
+		assert.Empty(t, diags)
+	})
+}
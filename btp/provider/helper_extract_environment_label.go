@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type EnvironmentLabelKey string
@@ -11,23 +14,24 @@ type EnvironmentLabelKey string
 const (
 	EnvironmentLabelKeyCfApiUrl          EnvironmentLabelKey = "API Endpoint"
 	EnvironmentLabelKeyCfOrgId           EnvironmentLabelKey = "Org ID"
+	EnvironmentLabelKeyCfOrgName         EnvironmentLabelKey = "Org Name"
 	EnvironmentLabelKeyKymaApiServerUrl  EnvironmentLabelKey = "APIServerURL"
 	EnvironmentLabelKeyKymaKubeconfigUrl EnvironmentLabelKey = "KubeconfigURL"
 )
 
+var environmentLabelKeys = []EnvironmentLabelKey{
+	EnvironmentLabelKeyCfApiUrl,
+	EnvironmentLabelKeyCfOrgId,
+	EnvironmentLabelKeyCfOrgName,
+	EnvironmentLabelKeyKymaApiServerUrl,
+	EnvironmentLabelKeyKymaKubeconfigUrl,
+}
+
 func (k EnvironmentLabelKey) String() string { return string(k) }
 
 // Validate that the provided key is supported
 func isValidEnvironmentLabelKey(k EnvironmentLabelKey) bool {
-	switch k {
-	case EnvironmentLabelKeyCfApiUrl,
-		EnvironmentLabelKeyCfOrgId,
-		EnvironmentLabelKeyKymaApiServerUrl,
-		EnvironmentLabelKeyKymaKubeconfigUrl:
-		return true
-	default:
-		return false
-	}
+	return slices.Contains(environmentLabelKeys, k)
 }
 
 // ExtractLabelValue parses a label string and extracts the value for the specified key.
@@ -64,3 +68,38 @@ func ExtractLabelValue(label string, key EnvironmentLabelKey) (string, error) {
 
 	return strVal, nil
 }
+
+// environmentLabelValue returns the value of the label as terraform value, null is returned as long as the label is not yet provided by the environment broker
+func environmentLabelValue(labels string, key EnvironmentLabelKey) types.String {
+	value, err := ExtractLabelValue(labels, key)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// ParseEnvironmentLabels parses a label string and returns the values of all supported keys that are contained in it.
+func ParseEnvironmentLabels(label string) (map[EnvironmentLabelKey]string, error) {
+
+	var baseErrorMsg = "error: failed to parse labels. Reason: "
+
+	if label == "" {
+		return nil, errors.New(baseErrorMsg + "label is empty")
+	}
+
+	if !json.Valid([]byte(label)) {
+		return nil, errors.New(baseErrorMsg + "label is not valid JSON")
+	}
+
+	values := map[EnvironmentLabelKey]string{}
+
+	for _, key := range environmentLabelKeys {
+		// the label is valid JSON, so an error only means that the key is missing or its value is not a string
+		if value, err := ExtractLabelValue(label, key); err == nil {
+			values[key] = value
+		}
+	}
+
+	return values, nil
+}
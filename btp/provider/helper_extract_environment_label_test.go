@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -28,6 +29,18 @@ func TestExtractLabelValue_Success_CfOrgId(t *testing.T) {
 	}
 }
 
+func TestExtractLabelValue_Success_CfOrgName(t *testing.T) {
+	label := `{"API Endpoint":"https://api.cf.example.com","Org Name":"example","Org ID":"8d818824-394a-abcd-0815-7a3c8ce93e57"}`
+	val, err := ExtractLabelValue(label, EnvironmentLabelKeyCfOrgName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "example"
+	if val != want {
+		t.Fatalf("got %q, want %q", val, want)
+	}
+}
+
 func TestExtractLabelValue_Success_KymaApiServerUrl(t *testing.T) {
 	label := `{"APIServerURL":"https://api.kyma.example.com","KubeconfigURL":"https://kyma.example.com/kubeconfig/ABC", "Name":"test-terraform-kyma"}`
 	val, err := ExtractLabelValue(label, EnvironmentLabelKeyKymaApiServerUrl)
@@ -96,3 +109,34 @@ func TestExtractLabelValue_Error_UnsupportedKey(t *testing.T) {
 		t.Fatalf("expected error for unsupported key, got nil")
 	}
 }
+
+func TestParseEnvironmentLabels(t *testing.T) {
+	label := `{"API Endpoint":"https://api.cf.example.com","Org Name":"cf-terraform-test","Org ID":"8d818824-394a-4bae-9088-7a3c8ce93e57","Org Memory Limit":"0MB"}`
+	values, err := ParseEnvironmentLabels(label)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[EnvironmentLabelKey]string{
+		EnvironmentLabelKeyCfApiUrl:  "https://api.cf.example.com",
+		EnvironmentLabelKeyCfOrgName: "cf-terraform-test",
+		EnvironmentLabelKeyCfOrgId:   "8d818824-394a-4bae-9088-7a3c8ce93e57",
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestParseEnvironmentLabels_Error_EmptyLabel(t *testing.T) {
+	_, err := ParseEnvironmentLabels("")
+	if err == nil {
+		t.Fatalf("expected error for empty label, got nil")
+	}
+}
+
+func TestParseEnvironmentLabels_Error_InvalidJSON(t *testing.T) {
+	_, err := ParseEnvironmentLabels("{invalid}")
+	if err == nil {
+		t.Fatalf("expected error for invalid JSON, got nil")
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	labelsFilterOperatorEq  = "eq"
+	labelsFilterOperatorIn  = "in"
+	labelsFilterConjunction = "and"
+)
+
+type LabelsFilterSyntax string
+
+const (
+	// LabelsFilterSyntaxServiceManager is the label query syntax of the service manager, e.g. used by btp_subaccount_service_instances
+	LabelsFilterSyntaxServiceManager LabelsFilterSyntax = "service_manager"
+	// LabelsFilterSyntaxCis is the label query syntax of the accounts service, e.g. used by btp_subaccounts
+	LabelsFilterSyntaxCis LabelsFilterSyntax = "cis"
+)
+
+func (s LabelsFilterSyntax) String() string { return string(s) }
+
+// BuildLabelsFilter builds a label query in the given syntax as expected by the `labels_filter` attributes.
+//
+// In the service manager syntax a key with a single value results in an `eq` expression, a key with multiple values in an
+// `in (...)` expression. The expressions are sorted by key and joined with `and`, single quotes in the values are escaped
+// by doubling them.
+//
+// The accounts service (CIS) only accepts a single `key=value` expression without quoting, so exactly one key with
+// exactly one value is supported in this syntax.
+func BuildLabelsFilter(labels map[string][]string, syntax LabelsFilterSyntax) (string, error) {
+
+	var baseErrorMsg = "error: failed to build labels filter. Reason: "
+
+	if len(labels) == 0 {
+		return "", errors.New(baseErrorMsg + "no labels given")
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := validateLabelsFilterKey(key); err != nil {
+			return "", errors.New(baseErrorMsg + err.Error())
+		}
+
+		if len(labels[key]) == 0 {
+			return "", errors.New(baseErrorMsg + "no values given for key '" + key + "'")
+		}
+	}
+
+	switch syntax {
+	case LabelsFilterSyntaxServiceManager:
+		return buildServiceManagerLabelsFilter(keys, labels), nil
+	case LabelsFilterSyntaxCis:
+		filter, err := buildCisLabelsFilter(keys, labels)
+		if err != nil {
+			return "", errors.New(baseErrorMsg + err.Error())
+		}
+		return filter, nil
+	default:
+		return "", errors.New(baseErrorMsg + "unsupported syntax '" + syntax.String() + "', expected '" + LabelsFilterSyntaxServiceManager.String() + "' or '" + LabelsFilterSyntaxCis.String() + "'")
+	}
+}
+
+func buildServiceManagerLabelsFilter(keys []string, labels map[string][]string) string {
+	expressions := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		values := labels[key]
+
+		if len(values) == 1 {
+			expressions = append(expressions, fmt.Sprintf("%s %s %s", key, labelsFilterOperatorEq, quoteLabelsFilterValue(values[0])))
+			continue
+		}
+
+		quoted := make([]string, len(values))
+		for i, value := range values {
+			quoted[i] = quoteLabelsFilterValue(value)
+		}
+		expressions = append(expressions, fmt.Sprintf("%s %s (%s)", key, labelsFilterOperatorIn, strings.Join(quoted, ",")))
+	}
+
+	return strings.Join(expressions, " "+labelsFilterConjunction+" ")
+}
+
+func buildCisLabelsFilter(keys []string, labels map[string][]string) (string, error) {
+	if len(keys) > 1 {
+		return "", errors.New("the syntax '" + LabelsFilterSyntaxCis.String() + "' supports only one key")
+	}
+
+	key := keys[0]
+	values := labels[key]
+
+	if len(values) > 1 {
+		return "", errors.New("the syntax '" + LabelsFilterSyntaxCis.String() + "' supports only one value for key '" + key + "'")
+	}
+
+	if strings.Contains(key, "=") {
+		return "", errors.New("key '" + key + "' must not contain '='")
+	}
+
+	return key + "=" + values[0], nil
+}
+
+func validateLabelsFilterKey(key string) error {
+	if key == "" {
+		return errors.New("key is empty")
+	}
+
+	if strings.ContainsFunc(key, func(r rune) bool { return unicode.IsSpace(r) || strings.ContainsRune("'(),", r) }) {
+		return errors.New("key '" + key + "' must not contain whitespaces, quotes, parentheses or commas")
+	}
+
+	if slices.Contains([]string{labelsFilterOperatorEq, labelsFilterOperatorIn, labelsFilterConjunction}, key) {
+		return errors.New("key '" + key + "' is a reserved word")
+	}
+
+	return nil
+}
+
+func quoteLabelsFilterValue(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLabelsFilter(t *testing.T) {
+	t.Run("service manager - single and multiple values", func(t *testing.T) {
+		filter, err := BuildLabelsFilter(map[string][]string{
+			"landscape": {"production"},
+			"team":      {"a", "b"},
+		}, LabelsFilterSyntaxServiceManager)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "landscape eq 'production' and team in ('a','b')", filter)
+	})
+
+	t.Run("service manager - escaping of single quotes", func(t *testing.T) {
+		filter, err := BuildLabelsFilter(map[string][]string{
+			"owner": {"O'Brien"},
+		}, LabelsFilterSyntaxServiceManager)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "owner eq 'O''Brien'", filter)
+	})
+
+	t.Run("cis - single value", func(t *testing.T) {
+		filter, err := BuildLabelsFilter(map[string][]string{
+			"my-label": {"my-value"},
+		}, LabelsFilterSyntaxCis)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "my-label=my-value", filter)
+	})
+
+	t.Run("error path - no labels", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{}, LabelsFilterSyntaxServiceManager)
+
+		assert.ErrorContains(t, err, "no labels given")
+	})
+
+	t.Run("error path - no values", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"team": {}}, LabelsFilterSyntaxServiceManager)
+
+		assert.ErrorContains(t, err, "no values given for key 'team'")
+	})
+
+	t.Run("error path - invalid key", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"my team": {"a"}}, LabelsFilterSyntaxServiceManager)
+
+		assert.ErrorContains(t, err, "key 'my team' must not contain whitespaces")
+	})
+
+	t.Run("error path - unsupported syntax", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"team": {"a"}}, LabelsFilterSyntax("odata"))
+
+		assert.ErrorContains(t, err, "unsupported syntax 'odata'")
+	})
+
+	t.Run("error path - cis with multiple keys", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"landscape": {"production"}, "team": {"a"}}, LabelsFilterSyntaxCis)
+
+		assert.ErrorContains(t, err, "supports only one key")
+	})
+
+	t.Run("error path - cis with multiple values", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"team": {"a", "b"}}, LabelsFilterSyntaxCis)
+
+		assert.ErrorContains(t, err, "supports only one value for key 'team'")
+	})
+
+	t.Run("error path - cis key with equals sign", func(t *testing.T) {
+		_, err := BuildLabelsFilter(map[string][]string{"a=b": {"c"}}, LabelsFilterSyntaxCis)
+
+		assert.ErrorContains(t, err, "key 'a=b' must not contain '='")
+	})
+}
@@ -85,7 +85,7 @@ func (r *directoryListResource) List(
 			result.Identity.SetAttribute(ctx, path.Root("directory_id"), dir.Guid)
 
 			if req.IncludeResource {
-				resDir, diags := directoryValueFrom(ctx, dir)
+				resDir, diags := directoryResourceValueFrom(ctx, dir)
 
 				result.Diagnostics.Append(diags...)
 
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+var _ list.ListResourceWithConfigure = &directoryApiCredentialListResource{}
+
+type directoryApiCredentialListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type directoryApiCredentialListResourceFilter struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+}
+
+func NewDirectoryApiCredentialListResource() list.ListResource {
+	return &directoryApiCredentialListResource{}
+}
+
+func (r *directoryApiCredentialListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_api_credential" // must match managed resource
+}
+
+func (r *directoryApiCredentialListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *directoryApiCredentialListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all API credentials of a directory. Secrets, certificates and keys are not returned by the API.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+		},
+	}
+}
+
+// List streams all API credentials of a directory from the API
+func (r *directoryApiCredentialListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter directoryApiCredentialListResourceFilter
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cliRes, _, err := r.cli.Security.ApiCredential.ListByDirectoryorSubaccount(ctx, &btpcli.ApiCredentialInput{
+		Directory: filter.DirectoryId.ValueString(),
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Api Credential (Directory)",
+			fmt.Sprintf("Failed to list API credentials: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, apiCredential := range cliRes {
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("directory_id"), filter.DirectoryId)
+			result.Identity.SetAttribute(ctx, path.Root("name"), types.StringValue(apiCredential.Name))
+
+			if req.IncludeResource {
+				resApiCredential, diags := directoryApiCredentialFromValue(ctx, apiCredential)
+				resApiCredential.DirectoryId = filter.DirectoryId
+
+				result.Diagnostics.Append(diags...)
+
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resApiCredential)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
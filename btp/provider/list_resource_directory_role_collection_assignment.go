@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+var _ list.ListResourceWithConfigure = &directoryRoleCollectionAssignmentListResource{}
+
+type directoryRoleCollectionAssignmentListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type directoryRoleCollectionAssignmentListResourceFilter struct {
+	DirectoryId        types.String `tfsdk:"directory_id"`
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+}
+
+func NewDirectoryRoleCollectionAssignmentListResource() list.ListResource {
+	return &directoryRoleCollectionAssignmentListResource{}
+}
+
+func (r *directoryRoleCollectionAssignmentListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_role_collection_assignment" // must match managed resource
+}
+
+func (r *directoryRoleCollectionAssignmentListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *directoryRoleCollectionAssignmentListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all users, groups and attributes that are assigned to a role collection of a directory.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"role_collection_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role collection.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// List streams all assignments of a role collection of a directory from the API
+func (r *directoryRoleCollectionAssignmentListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter directoryRoleCollectionAssignmentListResourceFilter
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, _, err := r.cli.Security.RoleCollection.GetUserAssignmentsByDirectory(ctx, filter.DirectoryId.ValueString(), filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Directory)",
+			fmt.Sprintf("Failed to list user assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	roleCollection, _, err := r.cli.Security.RoleCollection.GetByDirectoryWithAttributeMappings(ctx, filter.DirectoryId.ValueString(), filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Directory)",
+			fmt.Sprintf("Failed to list attribute assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	assignments := directoryRoleCollectionAssignmentsFrom(filter, roleCollectionMembersFromValue(users, roleCollection, roleCollectionMembers{}))
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, assignment := range assignments {
+			result := req.NewListResult(ctx)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, directoryRoleCollectionAssignmentIdentityFrom(assignment))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, assignment)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// directoryRoleCollectionAssignmentsFrom maps the members of a role collection to one assignment per user, group and attribute
+func directoryRoleCollectionAssignmentsFrom(filter directoryRoleCollectionAssignmentListResourceFilter, members roleCollectionMembers) []directoryRoleCollectionAssignmentType {
+	var assignments []directoryRoleCollectionAssignmentType
+
+	newAssignment := func(username types.String, origin types.String) directoryRoleCollectionAssignmentType {
+		return directoryRoleCollectionAssignmentType{
+			DirectoryId:        filter.DirectoryId,
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue(fmt.Sprintf("%s,%s,%s", filter.DirectoryId.ValueString(), filter.RoleCollectionName.ValueString(), username.ValueString())),
+			Username:           username,
+			Groupname:          types.StringNull(),
+			AttributeName:      types.StringNull(),
+			AttributeValue:     types.StringNull(),
+			Origin:             roleCollectionAssignmentOrigin(origin.ValueString()),
+		}
+	}
+
+	for _, user := range members.Users {
+		assignments = append(assignments, newAssignment(user.Username, user.Origin))
+	}
+
+	for _, group := range members.Groups {
+		assignment := newAssignment(types.StringNull(), group.Origin)
+		assignment.Groupname = group.Groupname
+		assignments = append(assignments, assignment)
+	}
+
+	for _, attribute := range members.Attributes {
+		assignment := newAssignment(types.StringNull(), attribute.Origin)
+		assignment.AttributeName = attribute.AttributeName
+		assignment.AttributeValue = attribute.AttributeValue
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments
+}
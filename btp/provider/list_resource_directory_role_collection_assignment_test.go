@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDirectoryRoleCollectionAssignmentListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "security/role-collection" || params["directory"] != "05368777-4934-41e8-9f3c-6ec5f4d564b9" {
+				return ""
+			}
+			return roleCollectionAssignmentsResponseForTest(action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listDirectoryRoleCollectionAssignmentQueryConfig("role_collection_assignment_list", "btp", "05368777-4934-41e8-9f3c-6ec5f4d564b9", "Subaccount Viewer"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_directory_role_collection_assignment.role_collection_assignment_list", 3),
+						querycheck.ExpectIdentity("btp_directory_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"directory_id":         knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.StringExact("jenny.doe@test.com"),
+							"group_name":           knownvalue.Null(),
+							"attribute_name":       knownvalue.Null(),
+							"attribute_value":      knownvalue.Null(),
+							"origin":               knownvalue.StringExact("ldap"),
+						}),
+						querycheck.ExpectIdentity("btp_directory_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"directory_id":         knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.Null(),
+							"group_name":           knownvalue.StringExact("admins"),
+							"attribute_name":       knownvalue.Null(),
+							"attribute_value":      knownvalue.Null(),
+							"origin":               knownvalue.StringExact("terraformint-platform"),
+						}),
+						querycheck.ExpectIdentity("btp_directory_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"directory_id":         knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.Null(),
+							"group_name":           knownvalue.Null(),
+							"attribute_name":       knownvalue.StringExact("Department"),
+							"attribute_value":      knownvalue.StringExact("IT"),
+							"origin":               knownvalue.StringExact("terraformint-platform"),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listDirectoryRoleCollectionAssignmentQueryConfigWithIncludeResource("role_collection_assignment_list", "btp", "05368777-4934-41e8-9f3c-6ec5f4d564b9", "Subaccount Viewer"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_directory_role_collection_assignment.role_collection_assignment_list", 3),
+						querycheck.ExpectResourceKnownValues(
+							"btp_directory_role_collection_assignment.role_collection_assignment_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"directory_id":         knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+								"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+								"user_name":            knownvalue.Null(),
+								"group_name":           knownvalue.StringExact("admins"),
+								"attribute_name":       knownvalue.Null(),
+								"attribute_value":      knownvalue.Null(),
+								"origin":               knownvalue.StringExact("terraformint-platform"),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("directory_id"),
+									KnownValue: knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+								},
+								{
+									Path:       tfjsonpath.New("role_collection_name"),
+									KnownValue: knownvalue.StringExact("Subaccount Viewer"),
+								},
+								{
+									Path:       tfjsonpath.New("group_name"),
+									KnownValue: knownvalue.StringExact("admins"),
+								},
+								{
+									Path:       tfjsonpath.New("origin"),
+									KnownValue: knownvalue.StringExact("terraformint-platform"),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewDirectoryRoleCollectionAssignmentListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func listDirectoryRoleCollectionAssignmentQueryConfig(label, providerName, directoryID, roleCollectionName string) string {
+	return fmt.Sprintf(`
+list "btp_directory_role_collection_assignment" "%s" {
+  provider = "%s"
+  config {
+    directory_id = "%s"
+    role_collection_name = "%s"
+  }
+}`, label, providerName, directoryID, roleCollectionName)
+}
+
+func listDirectoryRoleCollectionAssignmentQueryConfigWithIncludeResource(label, providerName, directoryID, roleCollectionName string) string {
+	return fmt.Sprintf(`
+list "btp_directory_role_collection_assignment" "%s" {
+  provider = "%s"
+  include_resource = true
+  config {
+    directory_id = "%s"
+    role_collection_name = "%s"
+  }
+}`, label, providerName, directoryID, roleCollectionName)
+}
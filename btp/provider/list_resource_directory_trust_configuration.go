@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &directoryTrustConfigurationListResource{}
+
+type directoryTrustConfigurationListResource struct {
+	client *btpcli.ClientFacade
+}
+
+func NewDirectoryTrustConfigurationListResource() list.ListResource {
+	return &directoryTrustConfigurationListResource{}
+}
+
+type directoryTrustConfigurationListResourceFilter struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+}
+
+func (r *directoryTrustConfigurationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_trust_configuration" // must match managed resource
+}
+
+func (r *directoryTrustConfigurationListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *directoryTrustConfigurationListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all trust configurations available for given directory_id.",
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List streams all trust configurations for given directory from the API
+func (r *directoryTrustConfigurationListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+
+	var (
+		filter directoryTrustConfigurationListResourceFilter
+	)
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cliRes, _, err := r.client.Security.Trust.ListByDirectory(ctx, filter.DirectoryId.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Trust Configuration (Directory)",
+			fmt.Sprintf("Failed to list trust configurations: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+
+		for _, trustConfig := range cliRes {
+
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("directory_id"), filter.DirectoryId)
+			result.Identity.SetAttribute(ctx, path.Root("origin"), types.StringValue(trustConfig.OriginKey))
+
+			if req.IncludeResource {
+				resTrustConfig, diags := directoryTrustConfigurationFromValue(ctx, filter.DirectoryId.ValueString(), trustConfig)
+
+				result.Diagnostics.Append(diags...)
+
+				// Set the resource information on the result
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resTrustConfig)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDirectoryTrustConfigurationListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewDirectoryTrustConfigurationListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
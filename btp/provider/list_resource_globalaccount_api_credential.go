@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+var _ list.ListResourceWithConfigure = &globalaccountApiCredentialListResource{}
+
+type globalaccountApiCredentialListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func NewGlobalaccountApiCredentialListResource() list.ListResource {
+	return &globalaccountApiCredentialListResource{}
+}
+
+func (r *globalaccountApiCredentialListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_globalaccount_api_credential" // must match managed resource
+}
+
+func (r *globalaccountApiCredentialListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *globalaccountApiCredentialListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all API credentials of the global account. Secrets, certificates and keys are not returned by the API. It does not require any input configuration filters.",
+	}
+}
+
+// List streams all API credentials of the global account from the API
+func (r *globalaccountApiCredentialListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	cliRes, _, err := r.cli.Security.ApiCredential.ListByGlobalAccount(ctx, &btpcli.ApiCredentialInput{})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Api Credential (Global Account)",
+			fmt.Sprintf("Failed to list API credentials: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, apiCredential := range cliRes {
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("name"), types.StringValue(apiCredential.Name))
+
+			if req.IncludeResource {
+				resApiCredential, diags := globalaccountApiCredentialFromValue(ctx, apiCredential)
+				// the global account ID is not read by the resource either, so it stays empty like after an import
+				resApiCredential.GlobalaccountId = types.StringNull()
+
+				result.Diagnostics.Append(diags...)
+
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resApiCredential)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
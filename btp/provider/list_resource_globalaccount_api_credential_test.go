@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGlobalaccountApiCredentialListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "security/api-credential" || params["globalAccount"] != testGlobalAccount {
+				return ""
+			}
+
+			switch action {
+			case "list":
+				return `[` + apiCredentialResponseForTest("my-api-credential", "sb-my-api-credential!b1234") + `]`
+			case "get":
+				if params["name"] == "my-api-credential" {
+					return apiCredentialResponseForTest("my-api-credential", "sb-my-api-credential!b1234")
+				}
+			}
+			return ""
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listGlobalaccountApiCredentialQueryConfig("api_credential_list", "btp"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_globalaccount_api_credential.api_credential_list", 1),
+						querycheck.ExpectIdentity("btp_globalaccount_api_credential.api_credential_list", map[string]knownvalue.Check{
+							"name": knownvalue.StringExact("my-api-credential"),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listGlobalaccountApiCredentialQueryConfigWithIncludeResource("api_credential_list", "btp"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_globalaccount_api_credential.api_credential_list", 1),
+						querycheck.ExpectResourceKnownValues(
+							"btp_globalaccount_api_credential.api_credential_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"name": knownvalue.StringExact("my-api-credential"),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("globalaccount_id"),
+									KnownValue: knownvalue.Null(),
+								},
+								{
+									Path:       tfjsonpath.New("name"),
+									KnownValue: knownvalue.StringExact("my-api-credential"),
+								},
+								{
+									Path:       tfjsonpath.New("client_id"),
+									KnownValue: knownvalue.StringExact("sb-my-api-credential!b1234"),
+								},
+								{
+									Path:       tfjsonpath.New("credential_type"),
+									KnownValue: knownvalue.StringExact("binding-secret"),
+								},
+								{
+									Path:       tfjsonpath.New("read_only"),
+									KnownValue: knownvalue.Bool(true),
+								},
+								{
+									Path:       tfjsonpath.New("client_secret"),
+									KnownValue: knownvalue.Null(),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewGlobalaccountApiCredentialListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func listGlobalaccountApiCredentialQueryConfig(label, providerName string) string {
+	return fmt.Sprintf(`
+list "btp_globalaccount_api_credential" "%s" {
+  provider = "%s"
+}`, label, providerName)
+}
+
+func listGlobalaccountApiCredentialQueryConfigWithIncludeResource(label, providerName string) string {
+	return fmt.Sprintf(`
+list "btp_globalaccount_api_credential" "%s" {
+  provider = "%s"
+  include_resource = true
+}`, label, providerName)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+var _ list.ListResourceWithConfigure = &globalaccountRoleCollectionAssignmentListResource{}
+
+type globalaccountRoleCollectionAssignmentListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type globalaccountRoleCollectionAssignmentListResourceFilter struct {
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+}
+
+func NewGlobalaccountRoleCollectionAssignmentListResource() list.ListResource {
+	return &globalaccountRoleCollectionAssignmentListResource{}
+}
+
+func (r *globalaccountRoleCollectionAssignmentListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_globalaccount_role_collection_assignment" // must match managed resource
+}
+
+func (r *globalaccountRoleCollectionAssignmentListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *globalaccountRoleCollectionAssignmentListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all users, groups and attributes that are assigned to a role collection of the global account.",
+		Attributes: map[string]schema.Attribute{
+			"role_collection_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role collection.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// List streams all assignments of a role collection of the global account from the API
+func (r *globalaccountRoleCollectionAssignmentListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter globalaccountRoleCollectionAssignmentListResourceFilter
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, _, err := r.cli.Security.RoleCollection.GetUserAssignmentsByGlobalAccount(ctx, filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Global Account)",
+			fmt.Sprintf("Failed to list user assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	roleCollection, _, err := r.cli.Security.RoleCollection.GetByGlobalAccountWithAttributeMapings(ctx, filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Global Account)",
+			fmt.Sprintf("Failed to list attribute assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	assignments := globalaccountRoleCollectionAssignmentsFrom(filter, roleCollectionMembersFromValue(users, roleCollection, roleCollectionMembers{}))
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, assignment := range assignments {
+			result := req.NewListResult(ctx)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, globalaccountRoleCollectionAssignmentIdentityFrom(assignment))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, assignment)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// globalaccountRoleCollectionAssignmentsFrom maps the members of a role collection to one assignment per user, group and attribute
+func globalaccountRoleCollectionAssignmentsFrom(filter globalaccountRoleCollectionAssignmentListResourceFilter, members roleCollectionMembers) []globalaccountRoleCollectionAssignmentType {
+	var assignments []globalaccountRoleCollectionAssignmentType
+
+	newAssignment := func(username types.String, origin types.String) globalaccountRoleCollectionAssignmentType {
+		return globalaccountRoleCollectionAssignmentType{
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue(fmt.Sprintf("%s,%s", filter.RoleCollectionName.ValueString(), username.ValueString())),
+			Username:           username,
+			Groupname:          types.StringNull(),
+			AttributeName:      types.StringNull(),
+			AttributeValue:     types.StringNull(),
+			Origin:             roleCollectionAssignmentOrigin(origin.ValueString()),
+		}
+	}
+
+	for _, user := range members.Users {
+		assignments = append(assignments, newAssignment(user.Username, user.Origin))
+	}
+
+	for _, group := range members.Groups {
+		assignment := newAssignment(types.StringNull(), group.Origin)
+		assignment.Groupname = group.Groupname
+		assignments = append(assignments, assignment)
+	}
+
+	for _, attribute := range members.Attributes {
+		assignment := newAssignment(types.StringNull(), attribute.Origin)
+		assignment.AttributeName = attribute.AttributeName
+		assignment.AttributeValue = attribute.AttributeValue
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+var _ list.ListResourceWithConfigure = &subaccountApiCredentialListResource{}
+
+type subaccountApiCredentialListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type subaccountApiCredentialListResourceFilter struct {
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+}
+
+func NewSubaccountApiCredentialListResource() list.ListResource {
+	return &subaccountApiCredentialListResource{}
+}
+
+func (r *subaccountApiCredentialListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_api_credential" // must match managed resource
+}
+
+func (r *subaccountApiCredentialListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *subaccountApiCredentialListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all API credentials of a subaccount. Secrets, certificates and keys are not returned by the API.",
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+		},
+	}
+}
+
+// List streams all API credentials of a subaccount from the API
+func (r *subaccountApiCredentialListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter subaccountApiCredentialListResourceFilter
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cliRes, _, err := r.cli.Security.ApiCredential.ListByDirectoryorSubaccount(ctx, &btpcli.ApiCredentialInput{
+		Subaccount: filter.SubaccountId.ValueString(),
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Api Credential (Subaccount)",
+			fmt.Sprintf("Failed to list API credentials: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, apiCredential := range cliRes {
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("subaccount_id"), filter.SubaccountId)
+			result.Identity.SetAttribute(ctx, path.Root("name"), types.StringValue(apiCredential.Name))
+
+			if req.IncludeResource {
+				resApiCredential, diags := subaccountApiCredentialFromValue(ctx, apiCredential)
+				resApiCredential.SubaccountId = filter.SubaccountId
+
+				result.Diagnostics.Append(diags...)
+
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resApiCredential)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSubaccountApiCredentialListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "security/api-credential" || params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" {
+				return ""
+			}
+
+			switch action {
+			case "list":
+				return `[` + apiCredentialResponseForTest("my-api-credential", "sb-my-api-credential!b1234") + `,` + apiCredentialResponseForTest("other-api-credential", "sb-other-api-credential!b1234") + `]`
+			case "get":
+				if params["name"] == "my-api-credential" {
+					return apiCredentialResponseForTest("my-api-credential", "sb-my-api-credential!b1234")
+				}
+			}
+			return ""
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountApiCredentialQueryConfig("api_credential_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_api_credential.api_credential_list", 2),
+						querycheck.ExpectIdentity("btp_subaccount_api_credential.api_credential_list", map[string]knownvalue.Check{
+							"subaccount_id": knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"name":          knownvalue.StringExact("my-api-credential"),
+						}),
+						querycheck.ExpectIdentity("btp_subaccount_api_credential.api_credential_list", map[string]knownvalue.Check{
+							"subaccount_id": knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"name":          knownvalue.StringExact("other-api-credential"),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountApiCredentialQueryConfigWithIncludeResource("api_credential_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_api_credential.api_credential_list", 2),
+						querycheck.ExpectResourceKnownValues(
+							"btp_subaccount_api_credential.api_credential_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"subaccount_id": knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								"name":          knownvalue.StringExact("my-api-credential"),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("subaccount_id"),
+									KnownValue: knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								},
+								{
+									Path:       tfjsonpath.New("name"),
+									KnownValue: knownvalue.StringExact("my-api-credential"),
+								},
+								{
+									Path:       tfjsonpath.New("client_id"),
+									KnownValue: knownvalue.StringExact("sb-my-api-credential!b1234"),
+								},
+								{
+									Path:       tfjsonpath.New("credential_type"),
+									KnownValue: knownvalue.StringExact("binding-secret"),
+								},
+								{
+									Path:       tfjsonpath.New("read_only"),
+									KnownValue: knownvalue.Bool(true),
+								},
+								{
+									Path:       tfjsonpath.New("client_secret"),
+									KnownValue: knownvalue.Null(),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewSubaccountApiCredentialListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func listSubaccountApiCredentialQueryConfig(label, providerName, subaccountID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_api_credential" "%s" {
+  provider = "%s"
+  config {
+    subaccount_id = "%s"
+  }
+}`, label, providerName, subaccountID)
+}
+
+func listSubaccountApiCredentialQueryConfigWithIncludeResource(label, providerName, subaccountID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_api_credential" "%s" {
+  provider = "%s"
+  include_resource = true
+  config {
+    subaccount_id = "%s"
+  }
+}`, label, providerName, subaccountID)
+}
+
+// apiCredentialResponseForTest returns an API credential as listed by the CLI server, the list does not contain any secrets
+func apiCredentialResponseForTest(name string, clientId string) string {
+	return fmt.Sprintf(`{"name":"%s","clientid":"%s","credential-type":"binding-secret","read-only":true,"tokenurl":"https://my-subdomain.authentication.eu10.hana.ondemand.com/oauth/token","apiurl":"https://api.authentication.eu10.hana.ondemand.com"}`, name, clientId)
+}
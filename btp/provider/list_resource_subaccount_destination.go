@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &subaccountDestinationListResource{}
+
+type subaccountDestinationListResource struct {
+	client *btpcli.ClientFacade
+}
+
+type subaccountDestinationListResourceFilter struct {
+	SubaccountID      types.String `tfsdk:"subaccount_id"`
+	ServiceInstanceID types.String `tfsdk:"service_instance_id"`
+}
+
+func NewSubaccountDestinationListResource() list.ListResource {
+	return &subaccountDestinationListResource{}
+}
+
+func (r *subaccountDestinationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_destination" // must match managed resource
+}
+
+func (r *subaccountDestinationListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subaccountDestinationListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all destinations available for given subaccount or service instance.",
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+			},
+			"service_instance_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service instance.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List streams all destinations available for given subaccount from the API
+func (r *subaccountDestinationListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var (
+		filter subaccountDestinationListResourceFilter
+	)
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cliRes, _, err := r.client.Connectivity.Destination.ListBySubaccount(ctx, filter.SubaccountID.ValueString(), filter.ServiceInstanceID.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Destination",
+			fmt.Sprintf("Failed to list destinations: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+
+		for _, destination := range cliRes {
+
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("subaccount_id"), filter.SubaccountID)
+			result.Identity.SetAttribute(ctx, path.Root("name"), types.StringValue(destination.DestinationConfiguration["Name"]))
+			result.Identity.SetAttribute(ctx, path.Root("service_instance_id"), filter.ServiceInstanceID)
+
+			if req.IncludeResource {
+				resDestination, diags := destinationResourceValueFrom(destination, filter.SubaccountID, filter.ServiceInstanceID)
+				resDestination.ID = types.StringValue(filter.SubaccountID.ValueString() + "," + resDestination.Name.ValueString() + "," + filter.ServiceInstanceID.ValueString())
+
+				result.Diagnostics.Append(diags...)
+
+				// Set the resource information on the result
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resDestination)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
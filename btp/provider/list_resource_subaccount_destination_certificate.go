@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &subaccountDestinationCertificateListResource{}
+
+type subaccountDestinationCertificateListResource struct {
+	client *btpcli.ClientFacade
+}
+
+type subaccountDestinationCertificateListResourceFilter struct {
+	SubaccountID      types.String `tfsdk:"subaccount_id"`
+	ServiceInstanceID types.String `tfsdk:"service_instance_id"`
+}
+
+func NewSubaccountDestinationCertificateListResource() list.ListResource {
+	return &subaccountDestinationCertificateListResource{}
+}
+
+func (r *subaccountDestinationCertificateListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_destination_certificate" // must match managed resource
+}
+
+func (r *subaccountDestinationCertificateListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subaccountDestinationCertificateListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all destination certificates available for given subaccount or service instance. The certificate content is not returned by the API.",
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+			},
+			"service_instance_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service instance. If set, only the certificates of the service instance are returned.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List streams all destination certificates available for given subaccount or service instance from the API
+func (r *subaccountDestinationCertificateListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var (
+		filter subaccountDestinationCertificateListResourceFilter
+	)
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cliRes, _, err := r.client.Connectivity.DestinationCertificate.List(ctx, filter.SubaccountID.ValueString(), filter.ServiceInstanceID.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Destination Certificate",
+			fmt.Sprintf("Failed to list destination certificates: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	certificates := cliRes["subaccount"]
+	if !filter.ServiceInstanceID.IsNull() {
+		certificates = cliRes["serviceInstance"]
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+
+		for _, certificate := range certificates {
+
+			result := req.NewListResult(ctx)
+
+			result.Identity.SetAttribute(ctx, path.Root("subaccount_id"), filter.SubaccountID)
+			result.Identity.SetAttribute(ctx, path.Root("certificate_name"), types.StringValue(certificate.Name))
+			result.Identity.SetAttribute(ctx, path.Root("service_instance_id"), filter.ServiceInstanceID)
+
+			if req.IncludeResource {
+				resCertificate, diags := subaccountDestinationCertificateValueFrom(ctx, certificate)
+				resCertificate.SubaccountId = filter.SubaccountID
+				resCertificate.ServiceInstanceId = filter.ServiceInstanceID
+				resCertificate.CertificateContent = types.StringNull()
+
+				result.Diagnostics.Append(diags...)
+
+				// Set the resource information on the result
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resCertificate)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
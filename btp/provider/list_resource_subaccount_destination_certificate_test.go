@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSubaccountDestinationCertificateListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path - service instance", func(t *testing.T) {
+		t.Parallel()
+		subaccountCertificate := `{"name":"subaccount-cert.pem","nodes":[{"type":"X509","format":"PEM","subject":"CN=subaccount-cert"}],"creation":{"generation_method":"generated","common_name":"subaccount-cert"}}`
+		instanceCertificate := `{"name":"instance-cert.pem","nodes":[{"type":"X509","format":"PEM","subject":"CN=instance-cert"}],"creation":{"generation_method":"generated","common_name":"instance-cert"}}`
+
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "connectivity/destination-certificate" || params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" {
+				return ""
+			}
+
+			switch {
+			case action == "list" && params["serviceInstance"] == nil:
+				return `[` + subaccountCertificate + `]`
+			case action == "list" && params["serviceInstance"] == "bc8a216f-1184-49dc-b4b4-17cfe2828965":
+				return `[` + instanceCertificate + `]`
+			case action == "get" && params["serviceInstance"] == "bc8a216f-1184-49dc-b4b4-17cfe2828965" && params["certName"] == "instance-cert.pem":
+				return instanceCertificate
+			}
+			return ""
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountDestinationCertificateQueryConfig("destination_certificate_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "bc8a216f-1184-49dc-b4b4-17cfe2828965"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_destination_certificate.destination_certificate_list", 1),
+						querycheck.ExpectIdentity("btp_subaccount_destination_certificate.destination_certificate_list", map[string]knownvalue.Check{
+							"subaccount_id":       knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"certificate_name":    knownvalue.StringExact("instance-cert.pem"),
+							"service_instance_id": knownvalue.StringExact("bc8a216f-1184-49dc-b4b4-17cfe2828965"),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountDestinationCertificateQueryConfigWithIncludeResource("destination_certificate_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "bc8a216f-1184-49dc-b4b4-17cfe2828965"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_destination_certificate.destination_certificate_list", 1),
+						querycheck.ExpectResourceKnownValues(
+							"btp_subaccount_destination_certificate.destination_certificate_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"subaccount_id":       knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								"certificate_name":    knownvalue.StringExact("instance-cert.pem"),
+								"service_instance_id": knownvalue.StringExact("bc8a216f-1184-49dc-b4b4-17cfe2828965"),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("certificate_name"),
+									KnownValue: knownvalue.StringExact("instance-cert.pem"),
+								},
+								{
+									Path:       tfjsonpath.New("service_instance_id"),
+									KnownValue: knownvalue.StringExact("bc8a216f-1184-49dc-b4b4-17cfe2828965"),
+								},
+								{
+									Path:       tfjsonpath.New("certificate_content"),
+									KnownValue: knownvalue.Null(),
+								},
+								{
+									Path:       tfjsonpath.New("certificate_nodes"),
+									KnownValue: knownvalue.ListSizeExact(1),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewSubaccountDestinationCertificateListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func listSubaccountDestinationCertificateQueryConfig(label, providerName, subaccountID, serviceInstanceID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_destination_certificate" "%s" {
+  provider = "%s"
+  config {
+    subaccount_id = "%s"
+    service_instance_id = "%s"
+  }
+}`, label, providerName, subaccountID, serviceInstanceID)
+}
+
+func listSubaccountDestinationCertificateQueryConfigWithIncludeResource(label, providerName, subaccountID, serviceInstanceID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_destination_certificate" "%s" {
+  provider = "%s"
+  include_resource = true
+  config {
+    subaccount_id = "%s"
+    service_instance_id = "%s"
+  }
+}`, label, providerName, subaccountID, serviceInstanceID)
+}
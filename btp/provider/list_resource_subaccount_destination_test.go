@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSubaccountDestinationListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		destination := `{"systemMetadata":{"creation_time":"1700000000000","etag":"5ce4b3a6-ee3c-4a5f-9b4e-d4e3c1ef13a7","modification_time":"1700000000000"},"destinationConfiguration":{"Name":"my-destination","Type":"HTTP","URL":"https://myservice.example.com","ProxyType":"Internet","Authentication":"NoAuthentication","Description":"My destination","HTML5.DynamicDestination":"true"}}`
+
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "connectivity/destination" || params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" {
+				return ""
+			}
+
+			switch action {
+			case "list":
+				return `[` + destination + `]`
+			case "get":
+				if params["name"] == "my-destination" {
+					return destination
+				}
+			}
+			return ""
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountDestinationQueryConfig("destination_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_destination.destination_list", 1),
+						querycheck.ExpectIdentity("btp_subaccount_destination.destination_list", map[string]knownvalue.Check{
+							"subaccount_id":       knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"name":                knownvalue.StringExact("my-destination"),
+							"service_instance_id": knownvalue.Null(),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountDestinationQueryConfigWithIncludeResource("destination_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_destination.destination_list", 1),
+						querycheck.ExpectResourceKnownValues(
+							"btp_subaccount_destination.destination_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"subaccount_id":       knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								"name":                knownvalue.StringExact("my-destination"),
+								"service_instance_id": knownvalue.Null(),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("id"),
+									KnownValue: knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,my-destination,"),
+								},
+								{
+									Path:       tfjsonpath.New("name"),
+									KnownValue: knownvalue.StringExact("my-destination"),
+								},
+								{
+									Path:       tfjsonpath.New("type"),
+									KnownValue: knownvalue.StringExact("HTTP"),
+								},
+								{
+									Path:       tfjsonpath.New("url"),
+									KnownValue: knownvalue.StringExact("https://myservice.example.com"),
+								},
+								{
+									Path:       tfjsonpath.New("creation_time"),
+									KnownValue: knownvalue.StringExact("2023-11-14T22:13:20Z"),
+								},
+								{
+									Path:       tfjsonpath.New("additional_configuration"),
+									KnownValue: knownvalue.StringExact(`{"HTML5.DynamicDestination":"true"}`),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewSubaccountDestinationListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func listSubaccountDestinationQueryConfig(label, providerName, subaccountID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_destination" "%s" {
+  provider = "%s"
+  config {
+    subaccount_id = "%s"
+  }
+}`, label, providerName, subaccountID)
+}
+
+func listSubaccountDestinationQueryConfigWithIncludeResource(label, providerName, subaccountID string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_destination" "%s" {
+  provider = "%s"
+  include_resource = true
+  config {
+    subaccount_id = "%s"
+  }
+}`, label, providerName, subaccountID)
+}
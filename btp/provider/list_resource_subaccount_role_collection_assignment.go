@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+var _ list.ListResourceWithConfigure = &subaccountRoleCollectionAssignmentListResource{}
+
+type subaccountRoleCollectionAssignmentListResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type subaccountRoleCollectionAssignmentListResourceFilter struct {
+	SubaccountId       types.String `tfsdk:"subaccount_id"`
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+}
+
+func NewSubaccountRoleCollectionAssignmentListResource() list.ListResource {
+	return &subaccountRoleCollectionAssignmentListResource{}
+}
+
+func (r *subaccountRoleCollectionAssignmentListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_role_collection_assignment" // must match managed resource
+}
+
+func (r *subaccountRoleCollectionAssignmentListResource) Configure(_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cli, ok := req.ProviderData.(*btpcli.ClientFacade)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *btpcli.ClientFacade, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cli = cli
+}
+
+func (r *subaccountRoleCollectionAssignmentListResource) ListResourceConfigSchema(
+	_ context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource allows you to discover all users, groups and attributes that are assigned to a role collection of a subaccount.",
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"role_collection_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role collection.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// List streams all assignments of a role collection of a subaccount from the API
+func (r *subaccountRoleCollectionAssignmentListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter subaccountRoleCollectionAssignmentListResourceFilter
+
+	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, _, err := r.cli.Security.RoleCollection.GetUserAssignmentsBySubaccount(ctx, filter.SubaccountId.ValueString(), filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Subaccount)",
+			fmt.Sprintf("Failed to list user assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	roleCollection, _, err := r.cli.Security.RoleCollection.GetBySubaccountWithAttributeMappings(ctx, filter.SubaccountId.ValueString(), filter.RoleCollectionName.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"API Error Reading Resource Role Collection Assignment (Subaccount)",
+			fmt.Sprintf("Failed to list attribute assignments: %s", err),
+		)
+
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	assignments := subaccountRoleCollectionAssignmentsFrom(filter, roleCollectionMembersFromValue(users, roleCollection, roleCollectionMembers{}))
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, assignment := range assignments {
+			result := req.NewListResult(ctx)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, subaccountRoleCollectionAssignmentIdentityFrom(assignment))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, assignment)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// subaccountRoleCollectionAssignmentsFrom maps the members of a role collection to one assignment per user, group and attribute
+func subaccountRoleCollectionAssignmentsFrom(filter subaccountRoleCollectionAssignmentListResourceFilter, members roleCollectionMembers) []subaccountRoleCollectionAssignmentType {
+	var assignments []subaccountRoleCollectionAssignmentType
+
+	newAssignment := func(username types.String, origin types.String) subaccountRoleCollectionAssignmentType {
+		return subaccountRoleCollectionAssignmentType{
+			SubaccountId:       filter.SubaccountId,
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue(fmt.Sprintf("%s,%s,%s", filter.SubaccountId.ValueString(), filter.RoleCollectionName.ValueString(), username.ValueString())),
+			Username:           username,
+			Groupname:          types.StringNull(),
+			AttributeName:      types.StringNull(),
+			AttributeValue:     types.StringNull(),
+			Origin:             roleCollectionAssignmentOrigin(origin.ValueString()),
+		}
+	}
+
+	for _, user := range members.Users {
+		assignments = append(assignments, newAssignment(user.Username, user.Origin))
+	}
+
+	for _, group := range members.Groups {
+		assignment := newAssignment(types.StringNull(), group.Origin)
+		assignment.Groupname = group.Groupname
+		assignments = append(assignments, assignment)
+	}
+
+	for _, attribute := range members.Attributes {
+		assignment := newAssignment(types.StringNull(), attribute.Origin)
+		assignment.AttributeName = attribute.AttributeName
+		assignment.AttributeValue = attribute.AttributeValue
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	res "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_authz"
+)
+
+func TestSubaccountRoleCollectionAssignmentListResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "security/role-collection" || params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" {
+				return ""
+			}
+			return roleCollectionAssignmentsResponseForTest(action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountRoleCollectionAssignmentQueryConfig("role_collection_assignment_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_role_collection_assignment.role_collection_assignment_list", 3),
+						querycheck.ExpectIdentity("btp_subaccount_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"subaccount_id":        knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.StringExact("jenny.doe@test.com"),
+							"group_name":           knownvalue.Null(),
+							"attribute_name":       knownvalue.Null(),
+							"attribute_value":      knownvalue.Null(),
+							"origin":               knownvalue.StringExact("ldap"),
+						}),
+						querycheck.ExpectIdentity("btp_subaccount_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"subaccount_id":        knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.Null(),
+							"group_name":           knownvalue.StringExact("admins"),
+							"attribute_name":       knownvalue.Null(),
+							"attribute_value":      knownvalue.Null(),
+							"origin":               knownvalue.StringExact("terraformint-platform"),
+						}),
+						querycheck.ExpectIdentity("btp_subaccount_role_collection_assignment.role_collection_assignment_list", map[string]knownvalue.Check{
+							"subaccount_id":        knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+							"user_name":            knownvalue.Null(),
+							"group_name":           knownvalue.Null(),
+							"attribute_name":       knownvalue.StringExact("Department"),
+							"attribute_value":      knownvalue.StringExact("IT"),
+							"origin":               knownvalue.StringExact("terraformint-platform"),
+						}),
+					},
+				},
+				{
+					Query:  true,
+					Config: hclProviderForCLIServerAt(srv.URL) + listSubaccountRoleCollectionAssignmentQueryConfigWithIncludeResource("role_collection_assignment_list", "btp", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer"),
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectLength("btp_subaccount_role_collection_assignment.role_collection_assignment_list", 3),
+						querycheck.ExpectResourceKnownValues(
+							"btp_subaccount_role_collection_assignment.role_collection_assignment_list",
+							queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+								"subaccount_id":        knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+								"user_name":            knownvalue.Null(),
+								"group_name":           knownvalue.StringExact("admins"),
+								"attribute_name":       knownvalue.Null(),
+								"attribute_value":      knownvalue.Null(),
+								"origin":               knownvalue.StringExact("terraformint-platform"),
+							}),
+							[]querycheck.KnownValueCheck{
+								{
+									Path:       tfjsonpath.New("subaccount_id"),
+									KnownValue: knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+								},
+								{
+									Path:       tfjsonpath.New("role_collection_name"),
+									KnownValue: knownvalue.StringExact("Subaccount Viewer"),
+								},
+								{
+									Path:       tfjsonpath.New("group_name"),
+									KnownValue: knownvalue.StringExact("admins"),
+								},
+								{
+									Path:       tfjsonpath.New("origin"),
+									KnownValue: knownvalue.StringExact("terraformint-platform"),
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - configure", func(t *testing.T) {
+		t.Parallel()
+		r := NewSubaccountRoleCollectionAssignmentListResource().(list.ListResourceWithConfigure)
+		resp := &res.ConfigureResponse{}
+		req := res.ConfigureRequest{
+			ProviderData: struct{}{}, // Wrong type
+		}
+
+		r.Configure(context.Background(), req, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Error("Expected error for invalid provider data type")
+		}
+	})
+}
+
+func TestSubaccountRoleCollectionAssignmentsFrom(t *testing.T) {
+	filter := subaccountRoleCollectionAssignmentListResourceFilter{
+		SubaccountId:       types.StringValue("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+		RoleCollectionName: types.StringValue("Subaccount Viewer"),
+	}
+
+	users := []xsuaa_authz.UserReference{
+		{Username: "jenny.doe@test.com", Origin: "sap.default"},
+	}
+
+	roleCollection := xsuaa_authz.RoleCollection{
+		SamlAttributeAssignment: []xsuaa_authz.SamlAttrAssignment{
+			{AttributeName: "Groups", AttributeValue: "admins", IdentityProvider: "terraformint-platform"},
+			{AttributeName: "Department", AttributeValue: "IT", IdentityProvider: "terraformint-platform"},
+		},
+	}
+
+	assignments := subaccountRoleCollectionAssignmentsFrom(filter, roleCollectionMembersFromValue(users, roleCollection, roleCollectionMembers{}))
+
+	assert.Equal(t, []subaccountRoleCollectionAssignmentType{
+		{
+			SubaccountId:       filter.SubaccountId,
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,Subaccount Viewer,jenny.doe@test.com"),
+			Username:           types.StringValue("jenny.doe@test.com"),
+			Groupname:          types.StringNull(),
+			AttributeName:      types.StringNull(),
+			AttributeValue:     types.StringNull(),
+			Origin:             types.StringValue("ldap"),
+		},
+		{
+			SubaccountId:       filter.SubaccountId,
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,Subaccount Viewer,"),
+			Username:           types.StringNull(),
+			Groupname:          types.StringValue("admins"),
+			AttributeName:      types.StringNull(),
+			AttributeValue:     types.StringNull(),
+			Origin:             types.StringValue("terraformint-platform"),
+		},
+		{
+			SubaccountId:       filter.SubaccountId,
+			RoleCollectionName: filter.RoleCollectionName,
+			Id:                 types.StringValue("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,Subaccount Viewer,"),
+			Username:           types.StringNull(),
+			Groupname:          types.StringNull(),
+			AttributeName:      types.StringValue("Department"),
+			AttributeValue:     types.StringValue("IT"),
+			Origin:             types.StringValue("terraformint-platform"),
+		},
+	}, assignments)
+}
+
+func listSubaccountRoleCollectionAssignmentQueryConfig(label, providerName, subaccountID, roleCollectionName string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_role_collection_assignment" "%s" {
+  provider = "%s"
+  config {
+    subaccount_id = "%s"
+    role_collection_name = "%s"
+  }
+}`, label, providerName, subaccountID, roleCollectionName)
+}
+
+func listSubaccountRoleCollectionAssignmentQueryConfigWithIncludeResource(label, providerName, subaccountID, roleCollectionName string) string {
+	return fmt.Sprintf(`
+list "btp_subaccount_role_collection_assignment" "%s" {
+  provider = "%s"
+  include_resource = true
+  config {
+    subaccount_id = "%s"
+    role_collection_name = "%s"
+  }
+}`, label, providerName, subaccountID, roleCollectionName)
+}
+
+// roleCollectionAssignmentsResponseForTest answers the user assignments and the attribute mappings of a role collection with one user, one group and one attribute
+func roleCollectionAssignmentsResponseForTest(action string, params map[string]any) string {
+	if action != "get" || params["roleCollectionName"] != "Subaccount Viewer" {
+		return ""
+	}
+
+	switch {
+	case params["showUserAssignments"] == "true":
+		return `{"count":1,"totalPages":1,"items":[{"username":"jenny.doe@test.com","origin":"sap.default","email":"jenny.doe@test.com"}]}`
+	case params["showAttributeMappings"] == "true":
+		return `{"name":"Subaccount Viewer","samlAttributeAssignment":[{"attributeName":"Groups","attributeValue":"admins","idpDisplayName":"terraformint-platform"},{"attributeName":"Department","attributeValue":"IT","idpDisplayName":"terraformint-platform"}]}`
+	}
+	return ""
+}
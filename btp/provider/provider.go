@@ -6,11 +6,19 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/sessioncache"
 	"github.com/SAP/terraform-provider-btp/internal/version"
 )
 
@@ -33,9 +42,12 @@ const idTokenFlow = "idTokenFlow"
 const ssoFlow = "ssoFlow"
 const assertionFlow = "assertionFlow"
 const btpCliSessionFlow = "btpCliSessionFlow"
+const oidcFlow = "oidcFlow"
 const errorMessagePostfixWithEnv = "If either is already set, ensure the value is not empty."
 const errorMessagePostfixWithoutEnv = "If it is already set, ensure the value is not empty."
 
+var regexpRetryableErrorCode = regexp.MustCompile(`^\d+/\d{3}$`)
+
 func New() provider.Provider {
 	return NewWithClient(http.DefaultClient)
 }
@@ -136,21 +148,252 @@ func (p *btpcliProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					stringvalidator.AlsoRequires(path.MatchRoot("tls_idp_url"), path.MatchRoot("tls_client_key"), path.MatchRoot("idp")),
 				},
 			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures the login with the OIDC token of a workload identity, e.g. of a GitHub Actions or GitLab CI pipeline. The token is used as assertion for the login and is therefore only supported when using a custom Identity Provider (IdP). The token is taken from `token_file`, from the environment variable named by `token_env_var` or from the `BTP_OIDC_TOKEN` environment variable. If none of them is available, the token is requested from the GitHub Actions token endpoint.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("username"), path.MatchRoot("password"), path.MatchRoot("idtoken"), path.MatchRoot("assertion"), path.MatchRoot("tls_idp_url"), path.MatchRoot("tls_client_key"), path.MatchRoot("tls_client_certificate")),
+					objectvalidator.AlsoRequires(path.MatchRoot("idp")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"token_file": schema.StringAttribute{
+						MarkdownDescription: "The path to a file containing the OIDC token. This can also be sourced from the `BTP_OIDC_TOKEN_FILE` environment variable.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("token_env_var")),
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"token_env_var": schema.StringAttribute{
+						MarkdownDescription: "The name of the environment variable containing the OIDC token, e.g. the variable defined via `id_tokens` in GitLab CI.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "The audience of the OIDC token requested from the GitHub Actions token endpoint.",
+						Optional:            true,
+					},
+					"token_exchange_url": schema.StringAttribute{
+						MarkdownDescription: "The token endpoint of the identity provider at which the OIDC token is exchanged for an id token before the login, e.g. `https://my-tenant.accounts.ondemand.com/oauth2/token`. The token is used as is if not set.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_id")),
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be a valid URL"),
+						},
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The client ID of the application in the identity provider that is used for the token exchange.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("token_exchange_url")),
+						},
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "The client secret of the application in the identity provider that is used for the token exchange. Not required for public clients.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_id")),
+						},
+					},
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures how requests to the BTP CLI server are retried in case of transient errors, e.g. throttling or locking. Requests are retried on the HTTP status codes `429`, `500`, `502`, `503` and `504` as well as on the BTP error code `30004/400`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether failed requests are retried. Defaults to `true`. This can also be sourced from the `BTP_RETRY_ENABLED` environment variable.",
+						Optional:            true,
+					},
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of attempts for a request including the initial one. Defaults to `7`. This can also be sourced from the `BTP_RETRY_MAX_ATTEMPTS` environment variable.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_wait": schema.StringAttribute{
+						MarkdownDescription: "The minimum time to wait between two attempts, e.g. `500ms` or `2s`. Defaults to `1s`. This can also be sourced from the `BTP_RETRY_MIN_WAIT` environment variable.",
+						Optional:            true,
+					},
+					"max_wait": schema.StringAttribute{
+						MarkdownDescription: "The maximum time to wait between two attempts, e.g. `30s` or `2m`. Defaults to `2m`. This can also be sourced from the `BTP_RETRY_MAX_WAIT` environment variable.",
+						Optional:            true,
+					},
+					"retryable_status_codes": schema.SetAttribute{
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "Additional HTTP status codes on which requests are retried. This can also be sourced from the `BTP_RETRY_STATUS_CODES` environment variable as a comma-separated list.",
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+					"retryable_error_codes": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Additional BTP error codes on which requests are retried, in the format `<error code>/<HTTP status code>` (e.g. `11006/429`). This can also be sourced from the `BTP_RETRY_ERROR_CODES` environment variable as a comma-separated list.",
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexpRetryableErrorCode, "must have the format <error code>/<HTTP status code>")),
+						},
+					},
+				},
+			},
+			"session_cache": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures an encrypted on-disk cache of the login session, so that subsequent provider invocations (e.g. `terraform plan` followed by `terraform apply`, or several provider aliases) reuse the session instead of logging in again. Sessions are cached per CLI server, global account, identity provider, user and credential. A cached session that is rejected by the server is removed from the cache. The cache is not used for the login via SSO or the btp CLI session.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the session cache is used. Defaults to `true` if the `session_cache` block is set. This can also be sourced from the `BTP_SESSION_CACHE_ENABLED` environment variable.",
+						Optional:            true,
+					},
+					"encryption_key": schema.StringAttribute{
+						MarkdownDescription: "The passphrase from which the key to encrypt the cached sessions is derived. Required if the session cache is enabled. This can also be sourced from the `BTP_SESSION_CACHE_KEY` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"directory": schema.StringAttribute{
+						MarkdownDescription: "The directory in which the sessions are cached. Defaults to the directory `terraform-provider-btp/sessions` in the user cache directory. This can also be sourced from the `BTP_SESSION_CACHE_DIR` environment variable.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"ttl": schema.StringAttribute{
+						MarkdownDescription: "The time after which a cached session is discarded, e.g. `30m` or `4h`. Defaults to `8h`. This can also be sourced from the `BTP_SESSION_CACHE_TTL` environment variable.",
+						Optional:            true,
+					},
+				},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures the client-side throttling of requests to the BTP CLI server, e.g. to avoid the rate limit error `11006/429` when running with a high parallelism. Independent of this configuration, a `Retry-After` header sent with a `429` or `503` response pauses all requests until the given point in time.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						MarkdownDescription: "The maximum number of requests per second. Not limited by default. This can also be sourced from the `BTP_RATE_LIMIT_REQUESTS_PER_SECOND` environment variable.",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0.01),
+						},
+					},
+					"burst": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of requests that can be sent at once before `requests_per_second` takes effect. Defaults to `requests_per_second` rounded up. This can also be sourced from the `BTP_RATE_LIMIT_BURST` environment variable.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_concurrent_requests": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of requests in flight at the same time. Not limited by default. This can also be sourced from the `BTP_RATE_LIMIT_MAX_CONCURRENT_REQUESTS` environment variable.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"commands": schema.ListNestedAttribute{
+						MarkdownDescription: "Additional limits for the commands starting with a given prefix. A request is subject to the global limits and to the limits of the most specific matching prefix.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"command_prefix": schema.StringAttribute{
+									MarkdownDescription: "The prefix of the commands the limits apply to, e.g. `security/` or `accounts/entitlement`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"requests_per_second": schema.Float64Attribute{
+									MarkdownDescription: "The maximum number of requests per second for the matching commands. Not limited by default.",
+									Optional:            true,
+									Validators: []validator.Float64{
+										float64validator.AtLeast(0.01),
+									},
+								},
+								"burst": schema.Int64Attribute{
+									MarkdownDescription: "The maximum number of requests for the matching commands that can be sent at once before `requests_per_second` takes effect. Defaults to `requests_per_second` rounded up.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"max_concurrent_requests": schema.Int64Attribute{
+									MarkdownDescription: "The maximum number of requests for the matching commands in flight at the same time. Not limited by default.",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 type providerData struct {
-	CLIServerURL         types.String `tfsdk:"cli_server_url"`
-	GlobalAccount        types.String `tfsdk:"globalaccount"`
-	Username             types.String `tfsdk:"username"`
-	Password             types.String `tfsdk:"password"`
-	Assertion            types.String `tfsdk:"assertion"`
-	IdToken              types.String `tfsdk:"idtoken"`
-	IdentityProvider     types.String `tfsdk:"idp"`
-	IdentityProviderURL  types.String `tfsdk:"tls_idp_url"`
-	TLSClientKey         types.String `tfsdk:"tls_client_key"`
-	TLSClientCertificate types.String `tfsdk:"tls_client_certificate"`
+	CLIServerURL         types.String           `tfsdk:"cli_server_url"`
+	GlobalAccount        types.String           `tfsdk:"globalaccount"`
+	Username             types.String           `tfsdk:"username"`
+	Password             types.String           `tfsdk:"password"`
+	Assertion            types.String           `tfsdk:"assertion"`
+	IdToken              types.String           `tfsdk:"idtoken"`
+	IdentityProvider     types.String           `tfsdk:"idp"`
+	IdentityProviderURL  types.String           `tfsdk:"tls_idp_url"`
+	TLSClientKey         types.String           `tfsdk:"tls_client_key"`
+	TLSClientCertificate types.String           `tfsdk:"tls_client_certificate"`
+	OIDC                 *providerOIDCData      `tfsdk:"oidc"`
+	Retry                *providerRetryData     `tfsdk:"retry"`
+	RateLimit            *providerRateLimitData `tfsdk:"rate_limit"`
+	SessionCache         *providerSessionCache  `tfsdk:"session_cache"`
+}
+
+type providerOIDCData struct {
+	TokenFile        types.String `tfsdk:"token_file"`
+	TokenEnvVar      types.String `tfsdk:"token_env_var"`
+	Audience         types.String `tfsdk:"audience"`
+	TokenExchangeURL types.String `tfsdk:"token_exchange_url"`
+	ClientID         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+}
+
+type providerRetryData struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MinWait              types.String `tfsdk:"min_wait"`
+	MaxWait              types.String `tfsdk:"max_wait"`
+	RetryableStatusCodes types.Set    `tfsdk:"retryable_status_codes"`
+	RetryableErrorCodes  types.Set    `tfsdk:"retryable_error_codes"`
+}
+
+type providerSessionCache struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	EncryptionKey types.String `tfsdk:"encryption_key"`
+	Directory     types.String `tfsdk:"directory"`
+	TTL           types.String `tfsdk:"ttl"`
+}
+
+type providerRateLimitData struct {
+	RequestsPerSecond     types.Float64                  `tfsdk:"requests_per_second"`
+	Burst                 types.Int64                    `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64                    `tfsdk:"max_concurrent_requests"`
+	Commands              []providerCommandRateLimitData `tfsdk:"commands"`
+}
+
+type providerCommandRateLimitData struct {
+	CommandPrefix         types.String  `tfsdk:"command_prefix"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 // Metadata returns the provider type name.
@@ -182,7 +425,26 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	client := btpcli.NewClientFacade(btpcli.NewV2ClientWithHttpClient(p.httpClient, u, nil))
+	retryConfig := resolveRetryConfig(ctx, config.Retry, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rateLimitConfig := resolveRateLimitConfig(config.RateLimit, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessionCache := resolveSessionCache(config.SessionCache, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := btpcli.NewClientFacade(btpcli.NewV2ClientWithHttpClient(p.httpClient, u, retryConfig))
+	client.UseRateLimit(rateLimitConfig)
+	if sessionCache != nil {
+		client.UseSessionCache(sessionCache)
+	}
 	btpUserAgent := os.Getenv("BTP_APPEND_USER_AGENT")
 
 	if len(strings.TrimSpace(btpUserAgent)) == 0 {
@@ -262,7 +524,7 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// flow beats USE_BTPCLI_SESSION / BTP_ENABLE_SSO with a warning, rather than
 	// erroring out below. tls_client_* participates as a flow selector too.
 	explicitAnyFlow := usernameExplicit || passwordExplicit || idTokenExplicit || assertionExplicit ||
-		!config.TLSClientKey.IsNull() || !config.TLSClientCertificate.IsNull() || !config.IdentityProviderURL.IsNull()
+		!config.TLSClientKey.IsNull() || !config.TLSClientCertificate.IsNull() || !config.IdentityProviderURL.IsNull() || config.OIDC != nil
 	btpCliSessionLogin, ssoLogin = dropCrossFlowEnvSwitches(explicitAnyFlow, btpCliSessionLogin, ssoLogin, resp)
 
 	//Check for conflicts between the different auth flows
@@ -331,6 +593,18 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
 		}
 
+	case oidcFlow:
+		oidcLoginReq := resolveOIDCLoginRequest(config, idp, resp)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err = client.OIDCLogin(ctx, oidcLoginReq); err != nil {
+			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
+			return
+		}
+
 	case btpCliSessionFlow:
 		if _, err = client.BtpCliSessionLogin(ctx, btpcli.NewBtpCliSessionLoginRequest(config.GlobalAccount.ValueString(), btpCliCustomConfigPath, idp)); err != nil {
 			resp.Diagnostics.AddError(unableToCreateClient, fmt.Sprintf("%s", err))
@@ -350,6 +624,7 @@ func (p *btpcliProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	resp.ResourceData = client
 	resp.ListResourceData = client
 	resp.ActionData = client
+	resp.EphemeralResourceData = client
 }
 
 // Resources - Defines provider resources
@@ -369,24 +644,33 @@ func (p *btpcliProvider) Resources(ctx context.Context) []func() resource.Resour
 		newDirectoryEntitlementResource,
 		newDirectoryRoleCollectionAssignmentResource,
 		newDirectoryRoleCollectionResource,
+		newDirectoryRoleCollectionMembersResource,
+		newDirectorySecuritySettingsResource,
+		newDirectoryTrustConfigurationResource,
 		newGlobalaccountApiCredentialResource,
 		newGlobalaccountResourceProviderResource,
 		newGlobalaccountRoleCollectionAssignmentResource,
 		newGlobalaccountRoleCollectionResource,
+		newGlobalaccountRoleCollectionMembersResource,
 		newGlobalaccountSecuritySettingsResource,
 		newGlobalaccountTrustConfigurationResource,
 		newSubaccountApiCredentialResource,
 		newSubaccountDestinationFragmentResource,
 		newSubaccountEntitlementResource,
+		newSubaccountEntitlementsResource,
+		newSubaccountCloudfoundryEnvironmentResource,
 		newSubaccountEnvironmentInstanceResource,
+		newSubaccountKymaEnvironmentResource,
 		newSubaccountResource,
 		newSubaccountRoleCollectionAssignmentResource,
 		newSubaccountRoleCollectionResource,
+		newSubaccountRoleCollectionMembersResource,
 		newSubaccountRoleCollectionRoleResource,
 		newSubaccountRoleCollectionBaseResource,
 		newSubaccountSecuritySettingsResource,
 		newSubaccountServiceBindingResource,
 		newSubaccountServiceBrokerResource,
+		newSubaccountServicePlatformResource,
 		newSubaccountServiceInstanceResource,
 		newSubaccountSubscriptionResource,
 		newSubaccountTrustConfigurationResource,
@@ -395,6 +679,7 @@ func (p *btpcliProvider) Resources(ctx context.Context) []func() resource.Resour
 		newSubaccountRoleResource,
 		newSubaccountDestinationResource,
 		newSubaccountDestinationCertificateResource,
+		newSubaccountDestinationTrustResource,
 		newSubaccountDestinationGenericResource,
 		newDisasterRecoverySubaccountPairResource,
 	}, betaResources...)
@@ -429,6 +714,9 @@ func (p *btpcliProvider) DataSources(ctx context.Context) []func() datasource.Da
 		newDirectoryRoleCollectionsDataSource,
 		newDirectoryRoleDataSource,
 		newDirectoryRolesDataSource,
+		newDirectorySecuritySettingsDataSource,
+		newDirectoryTrustConfigurationDataSource,
+		newDirectoryTrustConfigurationsDataSource,
 		newDirectoryUserDataSource,
 		newDirectoryUsersDataSource,
 		newGlobalaccountDataSource,
@@ -507,6 +795,8 @@ func (p *btpcliProvider) Functions(_ context.Context) []func() function.Function
 		NewExtractKymaApiServerUrlFunction,
 		NewExtractKymaKubeconfigUrlFunction,
 		NewDownloadKymaKubeconfigFunction,
+		NewBuildLabelsFilterFunction,
+		NewParseLabelsFunction,
 	}
 }
 
@@ -524,6 +814,7 @@ func (p *btpcliProvider) ListResources(_ context.Context) []func() list.ListReso
 		NewSubaccountListResource,
 		NewGlobalaccountTrustConfigurationListResource,
 		NewSubaccountTrustConfigurationListResource,
+		NewDirectoryTrustConfigurationListResource,
 		NewSubaccountServiceBindingListResource,
 		NewSubaccountSecuritySettingsListResource,
 		NewGlobalaccountSecuritySettingsListResource,
@@ -537,6 +828,24 @@ func (p *btpcliProvider) ListResources(_ context.Context) []func() list.ListReso
 		NewSubaccountEntitlementListResource,
 		NewSubaccountRoleCollectionRoleListResource,
 		NewSubaccountRoleCollectionBaseListResource,
+		NewSubaccountRoleCollectionAssignmentListResource,
+		NewDirectoryRoleCollectionAssignmentListResource,
+		NewGlobalaccountRoleCollectionAssignmentListResource,
+		NewSubaccountApiCredentialListResource,
+		NewDirectoryApiCredentialListResource,
+		NewGlobalaccountApiCredentialListResource,
+		NewSubaccountDestinationListResource,
+		NewSubaccountDestinationCertificateListResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *btpcliProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDirectoryApiCredentialEphemeralResource,
+		NewGlobalaccountApiCredentialEphemeralResource,
+		NewSubaccountApiCredentialEphemeralResource,
+		NewSubaccountServiceBindingCredentialsEphemeralResource,
 	}
 }
 
@@ -545,6 +854,7 @@ func (p *btpcliProvider) Actions(_ context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewRestoreSubaccountAction,
 		NewAddMeAsSubaccountAdminAction,
+		NewMoveSubaccountAction,
 	}
 }
 
@@ -574,8 +884,8 @@ func resolveWithEnv(cfg types.String, envName, attrName string, resp *provider.C
 // Schema ConflictsWith already covers explicit-vs-explicit; this covers
 // explicit-vs-env in either direction (e.g. explicit assertion + env
 // BTP_USERNAME, or explicit username + env BTP_ASSERTION).
-// x509 has no env fallback, so its explicit fields only participate as
-// flow selectors.
+// x509 and oidc have no env fallback for their flow selection, so their
+// explicit fields only participate as flow selectors.
 // ponytail: idp is a modifier (works with any flow), not touched here.
 func dropCrossFlowEnvValues(
 	cfg providerData,
@@ -587,6 +897,7 @@ func dropCrossFlowEnvValues(
 ) (string, string, string, string) {
 	explicitUserPw := usernameExplicit || passwordExplicit
 	explicitX509 := !cfg.TLSClientKey.IsNull() || !cfg.TLSClientCertificate.IsNull() || !cfg.IdentityProviderURL.IsNull()
+	explicitOIDC := cfg.OIDC != nil
 
 	warn := func(envName string) {
 		resp.Diagnostics.AddWarning(
@@ -597,7 +908,7 @@ func dropCrossFlowEnvValues(
 
 	// user/pw flow not explicitly picked, but env values leak in while another
 	// flow was picked explicitly — drop env-sourced user/pw.
-	otherThanUserPw := idTokenExplicit || assertionExplicit || explicitX509
+	otherThanUserPw := idTokenExplicit || assertionExplicit || explicitX509 || explicitOIDC
 	if !explicitUserPw && otherThanUserPw {
 		if !usernameExplicit && len(username) > 0 {
 			warn("BTP_USERNAME")
@@ -608,12 +919,12 @@ func dropCrossFlowEnvValues(
 			password = ""
 		}
 	}
-	otherThanIdToken := explicitUserPw || assertionExplicit || explicitX509
+	otherThanIdToken := explicitUserPw || assertionExplicit || explicitX509 || explicitOIDC
 	if !idTokenExplicit && otherThanIdToken && len(idToken) > 0 {
 		warn("BTP_IDTOKEN")
 		idToken = ""
 	}
-	otherThanAssertion := explicitUserPw || idTokenExplicit || explicitX509
+	otherThanAssertion := explicitUserPw || idTokenExplicit || explicitX509 || explicitOIDC
 	if !assertionExplicit && otherThanAssertion && len(assertion) > 0 {
 		warn("BTP_ASSERTION")
 		assertion = ""
@@ -646,6 +957,264 @@ func dropCrossFlowEnvSwitches(explicitAnyFlow, btpCliSessionLogin, ssoLogin bool
 	return btpCliSessionLogin, ssoLogin
 }
 
+// resolveRetryConfig merges the retry block of the provider configuration with the
+// BTP_RETRY_* environment variables. Explicit attributes take precedence over the
+// environment variables; values provided by neither fall back to the defaults of the
+// btp CLI client.
+func resolveRetryConfig(ctx context.Context, cfg *providerRetryData, resp *provider.ConfigureResponse) *btpcli.RetryConfig {
+	const invalidRetryConfig = "Invalid Retry Configuration"
+
+	retryConfig := btpcli.DefaultRetryConfig()
+
+	if cfg == nil {
+		cfg = &providerRetryData{}
+	}
+
+	if cfg.Enabled.IsUnknown() || cfg.MaxAttempts.IsUnknown() || cfg.MinWait.IsUnknown() || cfg.MaxWait.IsUnknown() || cfg.RetryableStatusCodes.IsUnknown() || cfg.RetryableErrorCodes.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("retry"), invalidRetryConfig, "Cannot use unknown values in the retry configuration")
+		return nil
+	}
+
+	addEnvError := func(envName string, err error) {
+		resp.Diagnostics.AddError(invalidRetryConfig, fmt.Sprintf("The value of the environment variable %q is invalid: %s", envName, err))
+	}
+
+	if !cfg.Enabled.IsNull() {
+		retryConfig.Enabled = cfg.Enabled.ValueBool()
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_RETRY_ENABLED")); len(envVal) > 0 {
+		enabled, err := strconv.ParseBool(envVal)
+		if err != nil {
+			addEnvError("BTP_RETRY_ENABLED", err)
+		}
+		retryConfig.Enabled = enabled
+	}
+
+	if !cfg.MaxAttempts.IsNull() {
+		retryConfig.RetryMax = int(cfg.MaxAttempts.ValueInt64()) - 1
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_RETRY_MAX_ATTEMPTS")); len(envVal) > 0 {
+		maxAttempts, err := strconv.Atoi(envVal)
+		if err == nil && maxAttempts < 1 {
+			err = fmt.Errorf("value must be at least 1, got: %d", maxAttempts)
+		}
+		if err != nil {
+			addEnvError("BTP_RETRY_MAX_ATTEMPTS", err)
+		}
+		retryConfig.RetryMax = maxAttempts - 1
+	}
+
+	resolveWait := func(cfgVal types.String, attrName string, envName string, defaultVal time.Duration) time.Duration {
+		if !cfgVal.IsNull() {
+			wait, err := time.ParseDuration(cfgVal.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("retry").AtName(attrName), invalidRetryConfig, fmt.Sprintf("%s", err))
+			}
+			return wait
+		}
+
+		if envVal := strings.TrimSpace(os.Getenv(envName)); len(envVal) > 0 {
+			wait, err := time.ParseDuration(envVal)
+			if err != nil {
+				addEnvError(envName, err)
+			}
+			return wait
+		}
+
+		return defaultVal
+	}
+
+	retryConfig.RetryWaitMin = resolveWait(cfg.MinWait, "min_wait", "BTP_RETRY_MIN_WAIT", retryConfig.RetryWaitMin)
+	retryConfig.RetryWaitMax = resolveWait(cfg.MaxWait, "max_wait", "BTP_RETRY_MAX_WAIT", retryConfig.RetryWaitMax)
+
+	if retryConfig.RetryWaitMin > retryConfig.RetryWaitMax {
+		resp.Diagnostics.AddAttributeError(path.Root("retry"), invalidRetryConfig, fmt.Sprintf("The minimum wait time (%s) must not exceed the maximum wait time (%s).", retryConfig.RetryWaitMin, retryConfig.RetryWaitMax))
+	}
+
+	if !cfg.RetryableStatusCodes.IsNull() {
+		var statusCodes []int64
+		resp.Diagnostics.Append(cfg.RetryableStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		for _, statusCode := range statusCodes {
+			retryConfig.RetryableStatusCodes = append(retryConfig.RetryableStatusCodes, int(statusCode))
+		}
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_RETRY_STATUS_CODES")); len(envVal) > 0 {
+		for _, value := range strings.Split(envVal, ",") {
+			statusCode, err := strconv.Atoi(strings.TrimSpace(value))
+			if err == nil && (statusCode < 400 || statusCode > 599) {
+				err = fmt.Errorf("status code must be between 400 and 599, got: %d", statusCode)
+			}
+			if err != nil {
+				addEnvError("BTP_RETRY_STATUS_CODES", err)
+				break
+			}
+			retryConfig.RetryableStatusCodes = append(retryConfig.RetryableStatusCodes, statusCode)
+		}
+	}
+
+	if !cfg.RetryableErrorCodes.IsNull() {
+		resp.Diagnostics.Append(cfg.RetryableErrorCodes.ElementsAs(ctx, &retryConfig.RetryableErrorCodes, false)...)
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_RETRY_ERROR_CODES")); len(envVal) > 0 {
+		for _, value := range strings.Split(envVal, ",") {
+			errorCode := strings.TrimSpace(value)
+			if !regexpRetryableErrorCode.MatchString(errorCode) {
+				addEnvError("BTP_RETRY_ERROR_CODES", fmt.Errorf("error code must have the format <error code>/<HTTP status code>, got: %q", errorCode))
+				break
+			}
+			retryConfig.RetryableErrorCodes = append(retryConfig.RetryableErrorCodes, errorCode)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	return retryConfig
+}
+
+// resolveRateLimitConfig merges the rate_limit block of the provider configuration with the
+// BTP_RATE_LIMIT_* environment variables. Explicit attributes take precedence over the
+// environment variables; the limits per command prefix can only be configured explicitly.
+func resolveRateLimitConfig(cfg *providerRateLimitData, resp *provider.ConfigureResponse) *btpcli.RateLimitConfig {
+	const invalidRateLimitConfig = "Invalid Rate Limit Configuration"
+
+	rateLimitConfig := btpcli.DefaultRateLimitConfig()
+
+	if cfg == nil {
+		cfg = &providerRateLimitData{}
+	}
+
+	if cfg.RequestsPerSecond.IsUnknown() || cfg.Burst.IsUnknown() || cfg.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("rate_limit"), invalidRateLimitConfig, "Cannot use unknown values in the rate limit configuration")
+		return nil
+	}
+
+	if !cfg.RequestsPerSecond.IsNull() {
+		rateLimitConfig.RequestsPerSecond = cfg.RequestsPerSecond.ValueFloat64()
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_RATE_LIMIT_REQUESTS_PER_SECOND")); len(envVal) > 0 {
+		requestsPerSecond, err := strconv.ParseFloat(envVal, 64)
+		if err == nil && requestsPerSecond <= 0 {
+			err = fmt.Errorf("value must be greater than 0, got: %s", envVal)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(invalidRateLimitConfig, fmt.Sprintf("The value of the environment variable %q is invalid: %s", "BTP_RATE_LIMIT_REQUESTS_PER_SECOND", err))
+		}
+		rateLimitConfig.RequestsPerSecond = requestsPerSecond
+	}
+
+	resolveCount := func(cfgVal types.Int64, envName string) int {
+		if !cfgVal.IsNull() {
+			return int(cfgVal.ValueInt64())
+		}
+
+		if envVal := strings.TrimSpace(os.Getenv(envName)); len(envVal) > 0 {
+			count, err := strconv.Atoi(envVal)
+			if err == nil && count < 1 {
+				err = fmt.Errorf("value must be at least 1, got: %d", count)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(invalidRateLimitConfig, fmt.Sprintf("The value of the environment variable %q is invalid: %s", envName, err))
+			}
+			return count
+		}
+
+		return 0
+	}
+
+	rateLimitConfig.Burst = resolveCount(cfg.Burst, "BTP_RATE_LIMIT_BURST")
+	rateLimitConfig.MaxConcurrentRequests = resolveCount(cfg.MaxConcurrentRequests, "BTP_RATE_LIMIT_MAX_CONCURRENT_REQUESTS")
+
+	for i, command := range cfg.Commands {
+		if command.CommandPrefix.IsUnknown() || command.RequestsPerSecond.IsUnknown() || command.Burst.IsUnknown() || command.MaxConcurrentRequests.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root("rate_limit").AtName("commands").AtListIndex(i), invalidRateLimitConfig, "Cannot use unknown values in the rate limit configuration")
+			return nil
+		}
+
+		rateLimitConfig.CommandLimits = append(rateLimitConfig.CommandLimits, btpcli.CommandRateLimitConfig{
+			CommandPrefix:         command.CommandPrefix.ValueString(),
+			RequestsPerSecond:     command.RequestsPerSecond.ValueFloat64(),
+			Burst:                 int(command.Burst.ValueInt64()),
+			MaxConcurrentRequests: int(command.MaxConcurrentRequests.ValueInt64()),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	return rateLimitConfig
+}
+
+// resolveSessionCache merges the session_cache block of the provider configuration with the
+// BTP_SESSION_CACHE_* environment variables. Explicit attributes take precedence over the
+// environment variables. It returns nil if the session cache is not enabled.
+func resolveSessionCache(cfg *providerSessionCache, resp *provider.ConfigureResponse) *sessioncache.Cache {
+	const invalidSessionCacheConfig = "Invalid Session Cache Configuration"
+
+	// the session cache is opt-in, but setting the block opts in
+	enabled := cfg != nil
+
+	if cfg == nil {
+		cfg = &providerSessionCache{}
+	}
+
+	if cfg.Enabled.IsUnknown() || cfg.EncryptionKey.IsUnknown() || cfg.Directory.IsUnknown() || cfg.TTL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("session_cache"), invalidSessionCacheConfig, "Cannot use unknown values in the session cache configuration")
+		return nil
+	}
+
+	addEnvError := func(envName string, err error) {
+		resp.Diagnostics.AddError(invalidSessionCacheConfig, fmt.Sprintf("The value of the environment variable %q is invalid: %s", envName, err))
+	}
+
+	if !cfg.Enabled.IsNull() {
+		enabled = cfg.Enabled.ValueBool()
+	} else if envVal := strings.TrimSpace(os.Getenv("BTP_SESSION_CACHE_ENABLED")); len(envVal) > 0 {
+		var err error
+		if enabled, err = strconv.ParseBool(envVal); err != nil {
+			addEnvError("BTP_SESSION_CACHE_ENABLED", err)
+			return nil
+		}
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	resolve := func(cfgVal types.String, envName string) string {
+		if !cfgVal.IsNull() {
+			return cfgVal.ValueString()
+		}
+		return strings.TrimSpace(os.Getenv(envName))
+	}
+
+	encryptionKey := resolve(cfg.EncryptionKey, "BTP_SESSION_CACHE_KEY")
+	if len(encryptionKey) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("session_cache").AtName("encryption_key"),
+			"Missing Session Cache Encryption Key",
+			"The provider cannot cache the session as there is a missing or empty value for the encryption key of the session cache. "+
+				"Set the encryption_key value in the session_cache block or use the BTP_SESSION_CACHE_KEY environment variable. "+
+				errorMessagePostfixWithEnv,
+		)
+		return nil
+	}
+
+	ttl := sessioncache.DefaultTTL
+	if ttlVal := resolve(cfg.TTL, "BTP_SESSION_CACHE_TTL"); len(ttlVal) > 0 {
+		var err error
+		if ttl, err = time.ParseDuration(ttlVal); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("session_cache").AtName("ttl"), invalidSessionCacheConfig, fmt.Sprintf("%s", err))
+			return nil
+		}
+	}
+
+	cache, err := sessioncache.New(resolve(cfg.Directory, "BTP_SESSION_CACHE_DIR"), encryptionKey, ttl)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("session_cache"), invalidSessionCacheConfig, fmt.Sprintf("%s", err))
+		return nil
+	}
+
+	return cache
+}
+
 func determineAuthFlow(config providerData, idToken string, ssoLogin bool, assertion string, btpCliSessionLogin bool) string {
 	if ssoLogin {
 		return ssoFlow
@@ -655,6 +1224,8 @@ func determineAuthFlow(config providerData, idToken string, ssoLogin bool, asser
 		return x509Flow
 	} else if len(assertion) > 0 {
 		return assertionFlow
+	} else if config.OIDC != nil {
+		return oidcFlow
 	} else if btpCliSessionLogin {
 		return btpCliSessionFlow
 	} else {
@@ -662,6 +1233,39 @@ func determineAuthFlow(config providerData, idToken string, ssoLogin bool, asser
 	}
 }
 
+// resolveOIDCLoginRequest builds the login request of the OIDC flow from the oidc block of the provider
+// configuration. If no token source is configured explicitly, the BTP_OIDC_TOKEN_FILE and BTP_OIDC_TOKEN
+// environment variables are used before falling back to the GitHub Actions token endpoint.
+func resolveOIDCLoginRequest(config providerData, idp string, resp *provider.ConfigureResponse) *btpcli.OIDCLoginRequest {
+	cfg := config.OIDC
+
+	if cfg.TokenFile.IsUnknown() || cfg.TokenEnvVar.IsUnknown() || cfg.Audience.IsUnknown() || cfg.TokenExchangeURL.IsUnknown() || cfg.ClientID.IsUnknown() || cfg.ClientSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("oidc"), "unableToCreateClient", "Cannot use unknown values in the oidc configuration")
+		return nil
+	}
+
+	loginReq := &btpcli.OIDCLoginRequest{
+		GlobalAccountSubdomain: config.GlobalAccount.ValueString(),
+		IdentityProvider:       idp,
+		TokenFile:              cfg.TokenFile.ValueString(),
+		TokenEnvVar:            cfg.TokenEnvVar.ValueString(),
+		Audience:               cfg.Audience.ValueString(),
+		TokenExchangeURL:       cfg.TokenExchangeURL.ValueString(),
+		ClientID:               cfg.ClientID.ValueString(),
+		ClientSecret:           cfg.ClientSecret.ValueString(),
+	}
+
+	if len(loginReq.TokenFile) == 0 && len(loginReq.TokenEnvVar) == 0 {
+		if tokenFile := strings.TrimSpace(os.Getenv("BTP_OIDC_TOKEN_FILE")); len(tokenFile) > 0 {
+			loginReq.TokenFile = tokenFile
+		} else if len(strings.TrimSpace(os.Getenv("BTP_OIDC_TOKEN"))) > 0 {
+			loginReq.TokenEnvVar = "BTP_OIDC_TOKEN"
+		}
+	}
+
+	return loginReq
+}
+
 func validateUserPasswordFlow(userName string, password string, resp *provider.ConfigureResponse) {
 	if len(userName) == 0 {
 		resp.Diagnostics.AddAttributeError(
@@ -2,18 +2,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -338,6 +343,55 @@ func notContainsCheckFunc(unexpectedSubString string) testingResource.CheckResou
 	}
 }
 
+// cliServerCommandHandler returns the JSON response of a CLI server command, an empty response is answered with 404
+type cliServerCommandHandler func(command string, action string, params map[string]any) string
+
+// cliServerBackendNotFoundForTest is returned by a cliServerCommandHandler if the backend does not find the requested object
+const cliServerBackendNotFoundForTest = "backend not found"
+
+// newCLIServerForTest starts a CLI server that accepts any login and answers the commands with the given handler
+func newCLIServerForTest(t *testing.T, handler cliServerCommandHandler) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/login/") {
+			w.Header().Set(btpcli.HeaderCLISessionId, "session")
+			_, _ = fmt.Fprintf(w, `{"issuer":"accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+			return
+		}
+
+		var payload struct {
+			ParamValues map[string]any `json:"paramValues"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// the path has the format /command/<protocol version>/<command>
+		_, command, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/command/"), "/")
+
+		body := handler(command, r.URL.RawQuery, payload.ParamValues)
+		if body == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if body == cliServerBackendNotFoundForTest {
+			w.Header().Set(btpcli.HeaderCLIBackendStatus, "404")
+			_, _ = fmt.Fprint(w, `{"error":"Not Found","description":"The requested object does not exist"}`)
+			return
+		}
+
+		w.Header().Set(btpcli.HeaderCLIBackendStatus, "200")
+		_, _ = fmt.Fprint(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
 func TestProvider_ConfigurationFlows(t *testing.T) {
 	t.Parallel()
 	t.Run("error path - user password login with missing data", func(t *testing.T) {
@@ -562,6 +616,60 @@ data "btp_whoami" "me" {}`,
 	})
 }
 
+func TestProvider_ConfigurationWithOIDC(t *testing.T) {
+	t.Run("error path - attribute conflicts with oidc", func(t *testing.T) {
+		testingResource.Test(t, testingResource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []testingResource.TestStep{
+				{
+					Config: `
+provider "btp" {
+	globalaccount = "ga"
+	idp           = "idp"
+	username      = "username"
+	oidc          = {}
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "username" cannot be specified when "oidc" is specified`),
+				},
+				{
+					Config: `
+provider "btp" {
+	globalaccount = "ga"
+	idp           = "idp"
+	assertion     = "assertion"
+	oidc          = {}
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "assertion" cannot be specified when "oidc" is specified`),
+				},
+				{
+					Config: `
+provider "btp" {
+	globalaccount = "ga"
+	oidc          = {}
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "idp" must be specified when "oidc" is specified`),
+				},
+				{
+					Config: `
+provider "btp" {
+	globalaccount = "ga"
+	idp           = "idp"
+	oidc = {
+		token_exchange_url = "https://my-tenant.accounts.ondemand.com/oauth2/token"
+	}
+}
+data "btp_whoami" "me" {}`,
+					ExpectError: regexp.MustCompile(`Attribute "oidc.client_id" must be specified when "oidc.token_exchange_url"`),
+				},
+			},
+		})
+	})
+}
+
 func TestProvider_AssertionFlow_failure(t *testing.T) {
 	testingResource.Test(t, testingResource.TestCase{
 		IsUnitTest:               true,
@@ -588,6 +696,9 @@ func TestProvider_HasResources(t *testing.T) {
 		"btp_directory_role",
 		"btp_directory_role_collection",
 		"btp_directory_role_collection_assignment",
+		"btp_directory_role_collection_members",
+		"btp_directory_security_settings",
+		"btp_directory_trust_configuration",
 		"btp_globalaccount_api_credential",
 		"btp_globalaccount_resource_provider",
 		"btp_globalaccount_role",
@@ -595,22 +706,29 @@ func TestProvider_HasResources(t *testing.T) {
 		"btp_subaccount_role_collection_role",
 		"btp_subaccount_role_collection_base",
 		"btp_globalaccount_role_collection_assignment",
+		"btp_globalaccount_role_collection_members",
 		"btp_globalaccount_security_settings",
 		"btp_globalaccount_trust_configuration",
 		"btp_subaccount_api_credential",
 		"btp_subaccount",
 		"btp_subaccount_entitlement",
+		"btp_subaccount_entitlements",
+		"btp_subaccount_cloudfoundry_environment",
 		"btp_subaccount_environment_instance",
+		"btp_subaccount_kyma_environment",
 		"btp_subaccount_role",
 		"btp_subaccount_role_collection",
 		"btp_subaccount_role_collection_assignment",
+		"btp_subaccount_role_collection_members",
 		"btp_subaccount_security_settings",
 		"btp_subaccount_service_instance",
 		"btp_subaccount_service_binding",
 		"btp_subaccount_service_broker",
+		"btp_subaccount_service_platform",
 		"btp_subaccount_subscription",
 		"btp_subaccount_trust_configuration",
 		"btp_subaccount_destination_certificate",
+		"btp_subaccount_destination_trust",
 		"btp_subaccount_destination_fragment",
 		"btp_subaccount_destination",
 		"btp_subaccount_destination_generic",
@@ -647,6 +765,7 @@ func TestProvider_HasListResources(t *testing.T) {
 		"btp_subaccount_trust_configuration",
 		"btp_subaccount_service_binding",
 		"btp_globalaccount_trust_configuration",
+		"btp_directory_trust_configuration",
 		"btp_subaccount_security_settings",
 		"btp_globalaccount_security_settings",
 		"btp_directory",
@@ -659,6 +778,14 @@ func TestProvider_HasListResources(t *testing.T) {
 		"btp_subaccount_entitlement",
 		"btp_subaccount_role_collection_base",
 		"btp_subaccount_role_collection_role",
+		"btp_subaccount_role_collection_assignment",
+		"btp_directory_role_collection_assignment",
+		"btp_globalaccount_role_collection_assignment",
+		"btp_subaccount_api_credential",
+		"btp_directory_api_credential",
+		"btp_globalaccount_api_credential",
+		"btp_subaccount_destination",
+		"btp_subaccount_destination_certificate",
 	}
 
 	p := New()
@@ -702,6 +829,9 @@ func TestProvider_HasDatasources(t *testing.T) {
 		"btp_directory_role_collection",
 		"btp_directory_role_collections",
 		"btp_directory_roles",
+		"btp_directory_security_settings",
+		"btp_directory_trust_configuration",
+		"btp_directory_trust_configurations",
 		"btp_directory_user",
 		"btp_directory_users",
 		"btp_globalaccount",
@@ -805,6 +935,8 @@ func TestProvider_HasFunctions(t *testing.T) {
 		"extract_kyma_api_server_url",
 		"extract_kyma_kubeconfig_url",
 		"download_kyma_kubeconfig",
+		"build_labels_filter",
+		"parse_labels",
 	}
 
 	ctx := context.Background()
@@ -823,6 +955,7 @@ func TestProvider_HasFunctions(t *testing.T) {
 func TestProvider_HasActions(t *testing.T) {
 	expectedActions := []string{
 		"btp_restore_subaccount",
+		"btp_move_subaccount",
 		"btp_add_me_as_subaccount_admin",
 	}
 
@@ -839,6 +972,33 @@ func TestProvider_HasActions(t *testing.T) {
 	assert.ElementsMatch(t, expectedActions, registeredActions)
 }
 
+func TestProvider_HasEphemeralResources(t *testing.T) {
+	expectedEphemeralResources := []string{
+		"btp_directory_api_credential",
+		"btp_globalaccount_api_credential",
+		"btp_subaccount_api_credential",
+		"btp_subaccount_service_binding_credentials",
+	}
+
+	ctx := context.Background()
+
+	ephemeralProvider, ok := New().(provider.ProviderWithEphemeralResources)
+	if !ok {
+		t.Fatalf("provider does not implement ProviderWithEphemeralResources")
+	}
+
+	registeredEphemeralResources := []string{}
+
+	for _, ephemeralResourceFunc := range ephemeralProvider.EphemeralResources(ctx) {
+		var resp ephemeral.MetadataResponse
+
+		ephemeralResourceFunc().Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "btp"}, &resp)
+		registeredEphemeralResources = append(registeredEphemeralResources, resp.TypeName)
+	}
+
+	assert.ElementsMatch(t, expectedEphemeralResources, registeredEphemeralResources)
+}
+
 func TestResolveWithEnv(t *testing.T) {
 	const envName = "BTP_TEST_RESOLVE"
 	const attrName = "test_attr"
@@ -926,6 +1086,7 @@ func TestDropCrossFlowEnvValues(t *testing.T) {
 	cases := []struct {
 		name             string
 		explicitX509     bool
+		explicitOIDC     bool
 		username         string
 		usernameExplicit bool
 		password         string
@@ -1007,6 +1168,19 @@ func TestDropCrossFlowEnvValues(t *testing.T) {
 			wantAssertion: "",
 			wantWarnings:  4,
 		},
+		{
+			name:          "explicit oidc drops env user/pw + idtoken + assertion",
+			explicitOIDC:  true,
+			username:      "env-u",
+			password:      "env-p",
+			idToken:       "env-idt",
+			assertion:     "env-as",
+			wantUsername:  "",
+			wantPassword:  "",
+			wantIdToken:   "",
+			wantAssertion: "",
+			wantWarnings:  4,
+		},
 		{
 			// Only username explicit — user/pw flow is selected; env idtoken/assertion drop.
 			name:             "explicit username only drops env idtoken + assertion, env password kept",
@@ -1029,6 +1203,9 @@ func TestDropCrossFlowEnvValues(t *testing.T) {
 			if tc.explicitX509 {
 				cfg.TLSClientKey = types.StringValue("key")
 			}
+			if tc.explicitOIDC {
+				cfg.OIDC = &providerOIDCData{}
+			}
 
 			resp := &provider.ConfigureResponse{}
 			username, password, idToken, assertion := dropCrossFlowEnvValues(
@@ -1058,3 +1235,414 @@ func TestDropCrossFlowEnvValues(t *testing.T) {
 		})
 	}
 }
+
+func TestDetermineAuthFlow(t *testing.T) {
+	oidcConfig := providerData{OIDC: &providerOIDCData{}}
+
+	assert.Equal(t, oidcFlow, determineAuthFlow(oidcConfig, "", false, "", false))
+	assert.Equal(t, oidcFlow, determineAuthFlow(oidcConfig, "", false, "", true))
+	assert.Equal(t, assertionFlow, determineAuthFlow(oidcConfig, "", false, "assertion", false))
+	assert.Equal(t, userPasswordFlow, determineAuthFlow(providerData{}, "", false, "", false))
+}
+
+func TestResolveOIDCLoginRequest(t *testing.T) {
+	oidcEnvNames := []string{"BTP_OIDC_TOKEN_FILE", "BTP_OIDC_TOKEN"}
+
+	cases := []struct {
+		name      string
+		cfg       *providerOIDCData
+		env       map[string]string
+		want      *btpcli.OIDCLoginRequest
+		wantError bool
+	}{
+		{
+			name: "explicit configuration",
+			cfg: &providerOIDCData{
+				TokenFile:        types.StringNull(),
+				TokenEnvVar:      types.StringValue("CI_JOB_JWT"),
+				Audience:         types.StringValue("my-audience"),
+				TokenExchangeURL: types.StringValue("https://my-tenant.accounts.ondemand.com/oauth2/token"),
+				ClientID:         types.StringValue("client-id"),
+				ClientSecret:     types.StringValue("client-secret"),
+			},
+			env: map[string]string{"BTP_OIDC_TOKEN": "env-token"},
+			want: &btpcli.OIDCLoginRequest{
+				GlobalAccountSubdomain: "ga",
+				IdentityProvider:       "my.custom.idp",
+				TokenEnvVar:            "CI_JOB_JWT",
+				Audience:               "my-audience",
+				TokenExchangeURL:       "https://my-tenant.accounts.ondemand.com/oauth2/token",
+				ClientID:               "client-id",
+				ClientSecret:           "client-secret",
+			},
+		},
+		{
+			name: "token file from env",
+			cfg:  &providerOIDCData{},
+			env:  map[string]string{"BTP_OIDC_TOKEN_FILE": "/var/run/token", "BTP_OIDC_TOKEN": "env-token"},
+			want: &btpcli.OIDCLoginRequest{
+				GlobalAccountSubdomain: "ga",
+				IdentityProvider:       "my.custom.idp",
+				TokenFile:              "/var/run/token",
+			},
+		},
+		{
+			name: "token from env",
+			cfg:  &providerOIDCData{},
+			env:  map[string]string{"BTP_OIDC_TOKEN": "env-token"},
+			want: &btpcli.OIDCLoginRequest{
+				GlobalAccountSubdomain: "ga",
+				IdentityProvider:       "my.custom.idp",
+				TokenEnvVar:            "BTP_OIDC_TOKEN",
+			},
+		},
+		{
+			name: "no token source falls back to GitHub Actions",
+			cfg:  &providerOIDCData{Audience: types.StringValue("my-audience")},
+			want: &btpcli.OIDCLoginRequest{
+				GlobalAccountSubdomain: "ga",
+				IdentityProvider:       "my.custom.idp",
+				Audience:               "my-audience",
+			},
+		},
+		{
+			name:      "unknown value",
+			cfg:       &providerOIDCData{TokenFile: types.StringUnknown()},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, envName := range oidcEnvNames {
+				t.Setenv(envName, tc.env[envName])
+			}
+
+			resp := &provider.ConfigureResponse{}
+			got := resolveOIDCLoginRequest(providerData{GlobalAccount: types.StringValue("ga"), OIDC: tc.cfg}, "my.custom.idp", resp)
+
+			if tc.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), "diags=%v", resp.Diagnostics)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveRetryConfig(t *testing.T) {
+	retryEnvNames := []string{"BTP_RETRY_ENABLED", "BTP_RETRY_MAX_ATTEMPTS", "BTP_RETRY_MIN_WAIT", "BTP_RETRY_MAX_WAIT", "BTP_RETRY_STATUS_CODES", "BTP_RETRY_ERROR_CODES"}
+
+	cases := []struct {
+		name      string
+		cfg       *providerRetryData
+		env       map[string]string
+		want      *btpcli.RetryConfig
+		wantError bool
+	}{
+		{
+			name: "no configuration falls back to defaults",
+			want: btpcli.DefaultRetryConfig(),
+		},
+		{
+			name: "explicit configuration",
+			cfg: &providerRetryData{
+				Enabled:              types.BoolValue(true),
+				MaxAttempts:          types.Int64Value(3),
+				MinWait:              types.StringValue("500ms"),
+				MaxWait:              types.StringValue("10s"),
+				RetryableStatusCodes: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(409)}),
+				RetryableErrorCodes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("11006/429")}),
+			},
+			want: &btpcli.RetryConfig{
+				Enabled:              true,
+				RetryMax:             2,
+				RetryWaitMin:         500 * time.Millisecond,
+				RetryWaitMax:         10 * time.Second,
+				RetryableStatusCodes: []int{409},
+				RetryableErrorCodes:  []string{"11006/429"},
+			},
+		},
+		{
+			name: "configuration from env",
+			env: map[string]string{
+				"BTP_RETRY_ENABLED":      "true",
+				"BTP_RETRY_MAX_ATTEMPTS": "1",
+				"BTP_RETRY_MIN_WAIT":     "2s",
+				"BTP_RETRY_MAX_WAIT":     "4s",
+				"BTP_RETRY_STATUS_CODES": "409, 423",
+				"BTP_RETRY_ERROR_CODES":  "11006/429,30004/400",
+			},
+			want: &btpcli.RetryConfig{
+				Enabled:              true,
+				RetryMax:             0,
+				RetryWaitMin:         2 * time.Second,
+				RetryWaitMax:         4 * time.Second,
+				RetryableStatusCodes: []int{409, 423},
+				RetryableErrorCodes:  []string{"11006/429", "30004/400"},
+			},
+		},
+		{
+			name: "explicit configuration wins over env",
+			cfg: &providerRetryData{
+				Enabled: types.BoolValue(false),
+			},
+			env: map[string]string{
+				"BTP_RETRY_ENABLED": "true",
+			},
+			want: &btpcli.RetryConfig{
+				Enabled:      false,
+				RetryMax:     6,
+				RetryWaitMin: 1 * time.Second,
+				RetryWaitMax: 120 * time.Second,
+			},
+		},
+		{
+			name:      "invalid duration",
+			cfg:       &providerRetryData{MinWait: types.StringValue("ten seconds")},
+			wantError: true,
+		},
+		{
+			name:      "minimum wait exceeds maximum wait",
+			cfg:       &providerRetryData{MinWait: types.StringValue("5m")},
+			wantError: true,
+		},
+		{
+			name:      "invalid max attempts from env",
+			env:       map[string]string{"BTP_RETRY_MAX_ATTEMPTS": "0"},
+			wantError: true,
+		},
+		{
+			name:      "invalid status code from env",
+			env:       map[string]string{"BTP_RETRY_STATUS_CODES": "200"},
+			wantError: true,
+		},
+		{
+			name:      "invalid error code from env",
+			env:       map[string]string{"BTP_RETRY_ERROR_CODES": "11006"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, envName := range retryEnvNames {
+				t.Setenv(envName, tc.env[envName])
+			}
+
+			resp := &provider.ConfigureResponse{}
+			got := resolveRetryConfig(context.Background(), tc.cfg, resp)
+
+			if tc.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), "diags=%v", resp.Diagnostics)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveRateLimitConfig(t *testing.T) {
+	rateLimitEnvNames := []string{"BTP_RATE_LIMIT_REQUESTS_PER_SECOND", "BTP_RATE_LIMIT_BURST", "BTP_RATE_LIMIT_MAX_CONCURRENT_REQUESTS"}
+
+	cases := []struct {
+		name      string
+		cfg       *providerRateLimitData
+		env       map[string]string
+		want      *btpcli.RateLimitConfig
+		wantError bool
+	}{
+		{
+			name: "no configuration falls back to defaults",
+			want: btpcli.DefaultRateLimitConfig(),
+		},
+		{
+			name: "explicit configuration",
+			cfg: &providerRateLimitData{
+				RequestsPerSecond:     types.Float64Value(5),
+				Burst:                 types.Int64Value(10),
+				MaxConcurrentRequests: types.Int64Value(4),
+				Commands: []providerCommandRateLimitData{
+					{
+						CommandPrefix:         types.StringValue("security/"),
+						RequestsPerSecond:     types.Float64Value(1.5),
+						Burst:                 types.Int64Null(),
+						MaxConcurrentRequests: types.Int64Value(2),
+					},
+				},
+			},
+			want: &btpcli.RateLimitConfig{
+				RequestsPerSecond:     5,
+				Burst:                 10,
+				MaxConcurrentRequests: 4,
+				CommandLimits: []btpcli.CommandRateLimitConfig{
+					{
+						CommandPrefix:         "security/",
+						RequestsPerSecond:     1.5,
+						MaxConcurrentRequests: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "configuration from env",
+			env: map[string]string{
+				"BTP_RATE_LIMIT_REQUESTS_PER_SECOND":     "0.5",
+				"BTP_RATE_LIMIT_BURST":                   "2",
+				"BTP_RATE_LIMIT_MAX_CONCURRENT_REQUESTS": "3",
+			},
+			want: &btpcli.RateLimitConfig{
+				RequestsPerSecond:     0.5,
+				Burst:                 2,
+				MaxConcurrentRequests: 3,
+			},
+		},
+		{
+			name: "explicit configuration wins over env",
+			cfg: &providerRateLimitData{
+				RequestsPerSecond: types.Float64Value(8),
+			},
+			env: map[string]string{
+				"BTP_RATE_LIMIT_REQUESTS_PER_SECOND": "2",
+			},
+			want: &btpcli.RateLimitConfig{
+				RequestsPerSecond: 8,
+			},
+		},
+		{
+			name:      "unknown value",
+			cfg:       &providerRateLimitData{RequestsPerSecond: types.Float64Unknown()},
+			wantError: true,
+		},
+		{
+			name:      "invalid requests per second from env",
+			env:       map[string]string{"BTP_RATE_LIMIT_REQUESTS_PER_SECOND": "0"},
+			wantError: true,
+		},
+		{
+			name:      "invalid max concurrent requests from env",
+			env:       map[string]string{"BTP_RATE_LIMIT_MAX_CONCURRENT_REQUESTS": "many"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, envName := range rateLimitEnvNames {
+				t.Setenv(envName, tc.env[envName])
+			}
+
+			resp := &provider.ConfigureResponse{}
+			got := resolveRateLimitConfig(tc.cfg, resp)
+
+			if tc.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), "diags=%v", resp.Diagnostics)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveSessionCache(t *testing.T) {
+	sessionCacheEnvNames := []string{"BTP_SESSION_CACHE_ENABLED", "BTP_SESSION_CACHE_KEY", "BTP_SESSION_CACHE_DIR", "BTP_SESSION_CACHE_TTL"}
+
+	cases := []struct {
+		name      string
+		cfg       *providerSessionCache
+		env       map[string]string
+		wantCache bool
+		wantError bool
+	}{
+		{
+			name: "no configuration disables the cache",
+		},
+		{
+			name: "configuration block enables the cache",
+			cfg: &providerSessionCache{
+				EncryptionKey: types.StringValue("secret"),
+				TTL:           types.StringValue("30m"),
+			},
+			wantCache: true,
+		},
+		{
+			name: "explicitly disabled",
+			cfg: &providerSessionCache{
+				Enabled:       types.BoolValue(false),
+				EncryptionKey: types.StringValue("secret"),
+			},
+			env:       map[string]string{"BTP_SESSION_CACHE_ENABLED": "true"},
+			wantCache: false,
+		},
+		{
+			name: "configuration from env",
+			env: map[string]string{
+				"BTP_SESSION_CACHE_ENABLED": "true",
+				"BTP_SESSION_CACHE_KEY":     "secret",
+				"BTP_SESSION_CACHE_TTL":     "1h",
+			},
+			wantCache: true,
+		},
+		{
+			name:      "missing encryption key",
+			cfg:       &providerSessionCache{},
+			wantError: true,
+		},
+		{
+			name: "invalid ttl",
+			cfg: &providerSessionCache{
+				EncryptionKey: types.StringValue("secret"),
+				TTL:           types.StringValue("eight hours"),
+			},
+			wantError: true,
+		},
+		{
+			name: "negative ttl",
+			cfg: &providerSessionCache{
+				EncryptionKey: types.StringValue("secret"),
+				TTL:           types.StringValue("-1h"),
+			},
+			wantError: true,
+		},
+		{
+			name:      "unknown value",
+			cfg:       &providerSessionCache{EncryptionKey: types.StringUnknown()},
+			wantError: true,
+		},
+		{
+			name:      "invalid enabled from env",
+			env:       map[string]string{"BTP_SESSION_CACHE_ENABLED": "sometimes"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, envName := range sessionCacheEnvNames {
+				t.Setenv(envName, tc.env[envName])
+			}
+			t.Setenv("BTP_SESSION_CACHE_DIR", t.TempDir())
+
+			resp := &provider.ConfigureResponse{}
+			got := resolveSessionCache(tc.cfg, resp)
+
+			if tc.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), "diags=%v", resp.Diagnostics)
+			assert.Equal(t, tc.wantCache, got != nil)
+		})
+	}
+}
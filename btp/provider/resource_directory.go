@@ -9,8 +9,8 @@ import (
 	"regexp"
 	"slices"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -48,7 +48,7 @@ func (rs *directoryResource) Configure(_ context.Context, req resource.Configure
 	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
 }
 
-func (rs *directoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (rs *directoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Directories allow you to organize and manage your subaccounts according to your technical and business needs. The use of directories is optional.
 
@@ -173,6 +173,14 @@ __Further documentation:__
 					getFormattedValueAsTableRow("`MIGRATING`", "Migrating entity from Neo to Cloud Foundry."),
 				Computed: true,
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the directory.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the directory.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the directory.",
+			}),
 		},
 	}
 }
@@ -192,7 +200,7 @@ func (rs *directoryResource) IdentitySchema(_ context.Context, _ resource.Identi
 }
 
 func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state directoryType
+	var state directoryResourceType
 
 	diags := req.State.Get(ctx, &state)
 
@@ -219,9 +227,12 @@ func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state, diags = directoryValueFrom(ctx, cliRes)
+	timeoutsLocal := state.Timeouts
+	state, diags = directoryResourceValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
+	state.Timeouts = timeoutsLocal
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -241,7 +252,7 @@ func (rs *directoryResource) Read(ctx context.Context, req resource.ReadRequest,
 func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	const createErrorHeader = "API Error Creating Resource Directory"
 
-	var plan directoryType
+	var plan directoryResourceType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -296,8 +307,13 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	plan, diags = directoryValueFrom(ctx, cliRes)
+	timeoutsLocal := plan.Timeouts
+	plan, diags = directoryResourceValueFrom(ctx, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	createTimeout, diags := timeoutsLocal.Create(ctx, tfutils.DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(createTimeout)
 
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
@@ -314,9 +330,9 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 
 			return subRes, subRes.EntityState, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    createTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
@@ -324,9 +340,11 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError(createErrorHeader, fmt.Sprintf("%s", err))
 	}
 
-	plan, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
+	plan, diags = directoryResourceValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
 	resp.Diagnostics.Append(diags...)
 
+	plan.Timeouts = timeoutsLocal
+
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	identity := directoryResourceIdentityModel{
@@ -340,8 +358,8 @@ func (rs *directoryResource) Create(ctx context.Context, req resource.CreateRequ
 func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	//This method makes two distinct calls: one to support features update using the BTP CLI "enable" command, and another for name, description and labels update.
 	//The methods are only called if the corresponding change should be triggered
-	var plan directoryType
-	var state directoryType
+	var plan directoryResourceType
+	var state directoryResourceType
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -389,6 +407,10 @@ func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
+	// The timeouts are not part of the API calls, so we take them over from the plan in any case
+	diags = resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+
 	// WORKAROUND for OpenTofu compatibility
 	// see https://github.com/SAP/terraform-provider-btp/issues/1383
 	identity := directoryResourceIdentityModel{
@@ -403,7 +425,7 @@ func (rs *directoryResource) Update(ctx context.Context, req resource.UpdateRequ
 func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	const deleteErrorHeader = "API Error Deleting Resource Directory"
 
-	var state directoryType
+	var state directoryResourceType
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -422,6 +444,10 @@ func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -441,9 +467,9 @@ func (rs *directoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 			return subRes, subRes.EntityState, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	_, err = deleteStateConf.WaitForStateContext(ctx)
@@ -484,7 +510,7 @@ func sortDiretoryFeatures(directoryFeatures []string) []string {
 	return directoryFeaturesSorted
 }
 
-func (rs *directoryResource) enableDirectory(ctx context.Context, plan directoryType, adminDirectoryId string, resp *resource.UpdateResponse) {
+func (rs *directoryResource) enableDirectory(ctx context.Context, plan directoryResourceType, adminDirectoryId string, resp *resource.UpdateResponse) {
 	// This function is responsible to update the features in case of an update
 
 	const updateErrorHeader = "API Error Updating Resource Directory"
@@ -509,8 +535,13 @@ func (rs *directoryResource) enableDirectory(ctx context.Context, plan directory
 		return
 	}
 
-	plan, diags := directoryValueFrom(ctx, cliRes)
+	timeoutsLocal := plan.Timeouts
+	plan, diags := directoryResourceValueFrom(ctx, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	updateTimeout, diags := timeoutsLocal.Update(ctx, tfutils.DefaultTimeout)
 	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(updateTimeout)
 
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
@@ -527,9 +558,9 @@ func (rs *directoryResource) enableDirectory(ctx context.Context, plan directory
 
 			return subRes, subRes.EntityState, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    updateTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	enabledRes, err := enableStateConf.WaitForStateContext(ctx)
@@ -537,14 +568,16 @@ func (rs *directoryResource) enableDirectory(ctx context.Context, plan directory
 		resp.Diagnostics.AddError(updateErrorHeader, fmt.Sprintf("%s", err))
 	}
 
-	plan, diags = directoryValueFrom(ctx, enabledRes.(cis.DirectoryResponseObject))
+	plan, diags = directoryResourceValueFrom(ctx, enabledRes.(cis.DirectoryResponseObject))
 	resp.Diagnostics.Append(diags...)
 
+	plan.Timeouts = timeoutsLocal
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (rs *directoryResource) updateDirectory(ctx context.Context, plan directoryType, adminDirectoryId string, resp *resource.UpdateResponse) {
+func (rs *directoryResource) updateDirectory(ctx context.Context, plan directoryResourceType, adminDirectoryId string, resp *resource.UpdateResponse) {
 	// This function is responsible to update the display name, description, and labels of directory resource
 	const updateErrorHeader = "API Error Updating Resource Directory"
 
@@ -577,9 +610,14 @@ func (rs *directoryResource) updateDirectory(ctx context.Context, plan directory
 		return
 	}
 
-	plan, diags := directoryValueFrom(ctx, cliRes)
+	timeoutsLocal := plan.Timeouts
+	plan, diags := directoryResourceValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
+	updateTimeout, diags := timeoutsLocal.Update(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(updateTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -595,9 +633,9 @@ func (rs *directoryResource) updateDirectory(ctx context.Context, plan directory
 
 			return subRes, subRes.EntityState, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    updateTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
@@ -605,9 +643,11 @@ func (rs *directoryResource) updateDirectory(ctx context.Context, plan directory
 		resp.Diagnostics.AddError(updateErrorHeader, fmt.Sprintf("%s", err))
 	}
 
-	plan, diags = directoryValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
+	plan, diags = directoryResourceValueFrom(ctx, updatedRes.(cis.DirectoryResponseObject))
 	resp.Diagnostics.Append(diags...)
 
+	plan.Timeouts = timeoutsLocal
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
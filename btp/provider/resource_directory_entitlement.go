@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -48,13 +48,16 @@ func (rs *directoryEntitlementResource) Configure(_ context.Context, req resourc
 	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
 }
 
-func (rs *directoryEntitlementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (rs *directoryEntitlementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Assigns the entitlement plan of a service, multitenant application, or environment, to a directory. Note that some environments, such as Cloud Foundry, are available by default to all global accounts and their directorys, and therefore are not made available as entitlements.
 
 __Tip:__
 You must be assigned to the admin role of the global account or the directory.
 
+__Note:__
+During planning, the service plan is validated against the entitlements of the global account. Unknown service plans are reported as errors, an amount that exceeds the remaining quota of the global account is reported as warning.
+
 __Further documentation:__
 <https://help.sap.com/docs/btp/sap-business-technology-platform/entitlements-and-quotas>`,
 		Attributes: map[string]schema.Attribute{
@@ -152,6 +155,14 @@ __Further documentation:__
 				MarkdownDescription: "The ID of the entitled service plan.",
 				Computed:            true,
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the entitlement.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the entitlement.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the entitlement.",
+			}),
 		},
 		Version: 1,
 	}
@@ -179,6 +190,54 @@ func (rs *directoryEntitlementResource) IdentitySchema(_ context.Context, _ reso
 	}
 }
 
+func (rs *directoryEntitlementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the resource gets destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || rs.cli == nil {
+		return
+	}
+
+	var plan directoryEntitlementType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ServiceName.IsUnknown() || plan.PlanName.IsUnknown() {
+		return
+	}
+
+	var currentAmount int64
+	if !req.State.Raw.IsNull() {
+		var state directoryEntitlementType
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		samePlan := plan.ServiceName.Equal(state.ServiceName) && plan.PlanName.Equal(state.PlanName)
+
+		// We only validate the entitlement if it changes to avoid unnecessary API calls
+		if samePlan && plan.Amount.Equal(state.Amount) {
+			return
+		}
+
+		// In case of a replacement the existing assignment does not count for the new plan
+		if samePlan {
+			currentAmount = state.Amount.ValueInt64()
+		}
+	}
+
+	cliRes, _, err := rs.cli.Accounts.Entitlement.ListByGlobalAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("API Error Validating Resource Entitlement (Directory)", fmt.Sprintf("The entitlements of the global account could not be fetched, the validation of the entitlement is skipped: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(validateEntitlementPlan(cliRes, entitlementSourceGlobalAccount, plan.ServiceName, plan.PlanName, plan.PlanUniqueIdentifier, plan.Amount, currentAmount)...)
+}
+
 func (rs *directoryEntitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state directoryEntitlementType
 
@@ -206,6 +265,8 @@ func (rs *directoryEntitlementResource) Read(ctx context.Context, req resource.R
 
 	resp.Diagnostics.Append(diags...)
 
+	updatedState.Timeouts = state.Timeouts
+
 	diags = resp.State.Set(ctx, &updatedState)
 	resp.Diagnostics.Append(diags...)
 
@@ -271,6 +332,10 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 		return
 	}
 
+	timeout, diags := entitlementTimeout(ctx, plan.Timeouts, action)
+	responseDiagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(timeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -296,9 +361,9 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 
 			return *entitlement, cis_entitlements.StateProcessing, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	entitlement, err := createStateConf.WaitForStateContext(ctx)
@@ -310,6 +375,8 @@ func (rs *directoryEntitlementResource) createOrUpdate(ctx context.Context, requ
 	updatedState, diags := directoryEntitlementValueFrom(ctx, entitlement.(btpcli.UnfoldedEntitlement), plan.DirectoryId.ValueString(), plan.Distribute.ValueBool())
 	responseDiagnostics.Append(diags...)
 
+	updatedState.Timeouts = plan.Timeouts
+
 	diags = responseState.Set(ctx, &updatedState)
 	responseDiagnostics.Append(diags...)
 
@@ -357,6 +424,10 @@ func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -377,9 +448,9 @@ func (rs *directoryEntitlementResource) Delete(ctx context.Context, req resource
 
 			return entitlement, cis_entitlements.StateProcessing, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	_, err = deleteStateConf.WaitForStateContext(ctx)
@@ -454,6 +525,21 @@ func checkForTargetStateReached(ctx context.Context, entitlement btpcli.Unfolded
 
 }
 
+// directoryEntitlementTypeV0 represents the state of schema version 0 which does not contain the timeouts
+type directoryEntitlementTypeV0 struct {
+	DirectoryId          types.String `tfsdk:"directory_id"`
+	Id                   types.String `tfsdk:"id"`
+	ServiceName          types.String `tfsdk:"service_name"`
+	PlanName             types.String `tfsdk:"plan_name"`
+	PlanUniqueIdentifier types.String `tfsdk:"plan_unique_identifier"`
+	Amount               types.Int64  `tfsdk:"amount"`
+	AutoAssign           types.Bool   `tfsdk:"auto_assign"`
+	AutoDistributeAmount types.Int64  `tfsdk:"auto_distribute_amount"`
+	Distribute           types.Bool   `tfsdk:"distribute"`
+	Category             types.String `tfsdk:"category"`
+	PlanId               types.String `tfsdk:"plan_id"`
+}
+
 func (rs *directoryEntitlementResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -538,7 +624,7 @@ func (rs *directoryEntitlementResource) UpgradeState(ctx context.Context) map[in
 			},
 
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-				var priorStateData directoryEntitlementType
+				var priorStateData directoryEntitlementTypeV0
 
 				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
 
@@ -557,6 +643,7 @@ func (rs *directoryEntitlementResource) UpgradeState(ctx context.Context) map[in
 					AutoAssign:           priorStateData.AutoAssign,
 					AutoDistributeAmount: priorStateData.AutoDistributeAmount,
 					Distribute:           priorStateData.Distribute,
+					Timeouts:             newNullTimeouts(),
 				}
 
 				if isTransferAmountRequired(priorStateData.Category.ValueString()) {
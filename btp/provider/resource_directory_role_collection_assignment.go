@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -142,6 +143,56 @@ You must be assigned to the admin role of the global account or the directory.`,
 	}
 }
 
+type directoryRoleCollectionAssignmentResourceIdentityModel struct {
+	DirectoryId        types.String `tfsdk:"directory_id"`
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+	Username           types.String `tfsdk:"user_name"`
+	Groupname          types.String `tfsdk:"group_name"`
+	AttributeName      types.String `tfsdk:"attribute_name"`
+	AttributeValue     types.String `tfsdk:"attribute_value"`
+	Origin             types.String `tfsdk:"origin"`
+}
+
+func directoryRoleCollectionAssignmentIdentityFrom(data directoryRoleCollectionAssignmentType) directoryRoleCollectionAssignmentResourceIdentityModel {
+	return directoryRoleCollectionAssignmentResourceIdentityModel{
+		DirectoryId:        data.DirectoryId,
+		RoleCollectionName: data.RoleCollectionName,
+		Username:           data.Username,
+		Groupname:          data.Groupname,
+		AttributeName:      data.AttributeName,
+		AttributeValue:     data.AttributeValue,
+		Origin:             data.Origin,
+	}
+}
+
+func (rs *directoryRoleCollectionAssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"directory_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"role_collection_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"user_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"group_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"attribute_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"attribute_value": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"origin": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
 func (rs *directoryRoleCollectionAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state directoryRoleCollectionAssignmentType
 
@@ -151,45 +202,67 @@ func (rs *directoryRoleCollectionAssignmentResource) Read(ctx context.Context, r
 		return
 	}
 
+	isAssigned, err := rs.isAssigned(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	if !isAssigned {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Id.IsNull() {
+		// Setting ID of state - required by hashicorps terraform plugin testing framework for Import. See issue https://github.com/hashicorp/terraform-plugin-testing/issues/84
+		state.Id = types.StringValue(fmt.Sprintf("%s,%s,%s", state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString(), state.Username.ValueString()))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity directoryRoleCollectionAssignmentResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.DirectoryId.IsNull() {
+		identity = directoryRoleCollectionAssignmentIdentityFrom(state)
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+// isAssigned checks whether the user, group or attribute of the state is assigned to the role collection
+func (rs *directoryRoleCollectionAssignmentResource) isAssigned(ctx context.Context, state directoryRoleCollectionAssignmentType) (bool, error) {
 	if !state.Username.IsNull() {
 		users, _, err := rs.cli.Security.RoleCollection.GetUserAssignmentsByDirectory(ctx, state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Directory)", fmt.Sprintf("%s", err))
-			return
+			return false, err
 		}
 		for _, u := range users {
 			if (u.Username == state.Username.ValueString() || u.Email == state.Username.ValueString()) && originMatches(u.Origin, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		}
-		resp.State.RemoveResource(ctx)
-		return
+		return false, nil
 	}
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.GetByDirectoryWithAttributeMappings(ctx, state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Directory)", fmt.Sprintf("%s", err))
-		return
+		return false, err
 	}
 
 	for _, am := range cliRes.SamlAttributeAssignment {
 		if !state.Groupname.IsNull() {
 			if am.AttributeName == "Groups" && am.AttributeValue == state.Groupname.ValueString() && originMatches(am.IdentityProvider, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		} else {
 			if am.AttributeName == state.AttributeName.ValueString() && am.AttributeValue == state.AttributeValue.ValueString() && originMatches(am.IdentityProvider, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		}
 	}
-	resp.State.RemoveResource(ctx)
+	return false, nil
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -222,6 +295,8 @@ func (rs *directoryRoleCollectionAssignmentResource) Create(ctx context.Context,
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, directoryRoleCollectionAssignmentIdentityFrom(plan))...)
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -269,8 +344,29 @@ func (rs *directoryRoleCollectionAssignmentResource) Delete(ctx context.Context,
 }
 
 func (rs *directoryRoleCollectionAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.AddError(
-		"Import Not Supported",
-		"Import is not supported for this resource. Use the resource directory_role_collection instead.",
-	)
+	if req.ID != "" {
+		resp.Diagnostics.AddError(
+			"Import Not Supported",
+			"Import by ID is not supported for this resource. Use an import block with the identity of the role collection assignment instead, e.g. as returned by the list resource btp_directory_role_collection_assignment.",
+		)
+		return
+	}
+
+	var identityData directoryRoleCollectionAssignmentResourceIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identityData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if identityData.Origin.IsNull() {
+		identityData.Origin = types.StringValue("ldap")
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), identityData.DirectoryId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_collection_name"), identityData.RoleCollectionName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), identityData.Username)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), identityData.Groupname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_name"), identityData.AttributeName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_value"), identityData.AttributeValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), identityData.Origin)...)
 }
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newDirectoryRoleCollectionMembersResource() resource.Resource {
+	return &directoryRoleCollectionMembersResource{}
+}
+
+type directoryRoleCollectionMembersType struct {
+	DirectoryId        types.String                        `tfsdk:"directory_id"`
+	Id                 types.String                        `tfsdk:"id"`
+	RoleCollectionName types.String                        `tfsdk:"role_collection_name"`
+	Users              []roleCollectionMemberUserType      `tfsdk:"users"`
+	Groups             []roleCollectionMemberGroupType     `tfsdk:"groups"`
+	Attributes         []roleCollectionMemberAttributeType `tfsdk:"attributes"`
+}
+
+func (data *directoryRoleCollectionMembersType) members() roleCollectionMembers {
+	return roleCollectionMembers{
+		Users:      data.Users,
+		Groups:     data.Groups,
+		Attributes: data.Attributes,
+	}
+}
+
+func (data *directoryRoleCollectionMembersType) setMembers(members roleCollectionMembers) {
+	data.Users = members.Users
+	data.Groups = members.Groups
+	data.Attributes = members.Attributes
+}
+
+type directoryRoleCollectionMembersResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *directoryRoleCollectionMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_directory_role_collection_members", req.ProviderTypeName)
+}
+
+func (rs *directoryRoleCollectionMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *directoryRoleCollectionMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"directory_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the directory.",
+			Required:            true,
+			Validators: []validator.String{
+				uuidvalidator.ValidUUID(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"role_collection_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role collection.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The combined unique ID of the role collection members.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	maps.Copy(attributes, roleCollectionMembersAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages all users, groups and attribute mappings that are assigned to a role collection on a directory level. The resource is authoritative: assignments that are not part of the configuration are removed, including assignments made outside of Terraform.
+
+__Tip:__
+You must be assigned to the admin role of the global account or the directory.
+
+__Notes:__
+- Do not combine this resource with the resource ` + "`btp_directory_role_collection_assignment`" + ` for the same role collection, as both would manage the same assignments.
+- Omitting one of the attributes ` + "`users`" + `, ` + "`groups`" + ` or ` + "`attributes`" + ` removes all assignments of this kind.
+- Destroying the resource removes all assignments from the role collection. The role collection itself is not deleted.`,
+		Attributes: attributes,
+	}
+}
+
+type directoryRoleCollectionMembersResourceIdentityModel struct {
+	DirectoryId        types.String `tfsdk:"directory_id"`
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+}
+
+func (rs *directoryRoleCollectionMembersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"directory_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"role_collection_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *directoryRoleCollectionMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryRoleCollectionMembersType
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, rawRes, err := rs.readMembers(ctx, state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString(), state.members())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, nil, resp, err, "Resource Role Collection Members (Directory)")
+		return
+	}
+
+	state.Id = types.StringValue(fmt.Sprintf("%s,%s", state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString()))
+	state.setMembers(members)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity directoryRoleCollectionMembersResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.DirectoryId.IsNull() {
+		identity = directoryRoleCollectionMembersResourceIdentityModel{
+			DirectoryId:        state.DirectoryId,
+			RoleCollectionName: state.RoleCollectionName,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+func (rs *directoryRoleCollectionMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryRoleCollectionMembersType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, plan.DirectoryId.ValueString(), plan.RoleCollectionName.ValueString(), plan.members())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Members (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s,%s", plan.DirectoryId.ValueString(), plan.RoleCollectionName.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := directoryRoleCollectionMembersResourceIdentityModel{
+		DirectoryId:        plan.DirectoryId,
+		RoleCollectionName: plan.RoleCollectionName,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *directoryRoleCollectionMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan directoryRoleCollectionMembersType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, plan.DirectoryId.ValueString(), plan.RoleCollectionName.ValueString(), plan.members())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Role Collection Members (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s,%s", plan.DirectoryId.ValueString(), plan.RoleCollectionName.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := directoryRoleCollectionMembersResourceIdentityModel{
+		DirectoryId:        plan.DirectoryId,
+		RoleCollectionName: plan.RoleCollectionName,
+	}
+
+	// Set Identity for OpenTofu compatibility also during Update
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *directoryRoleCollectionMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directoryRoleCollectionMembersType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, state.DirectoryId.ValueString(), state.RoleCollectionName.ValueString(), roleCollectionMembers{})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Members (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *directoryRoleCollectionMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: directory_id, role_collection_name. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_collection_name"), idParts[1])...)
+		return
+	}
+
+	var identityData directoryRoleCollectionMembersResourceIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identityData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), identityData.DirectoryId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_collection_name"), identityData.RoleCollectionName)...)
+}
+
+// readMembers fetches the users, groups and attribute mappings that are currently assigned to the role collection
+func (rs *directoryRoleCollectionMembersResource) readMembers(ctx context.Context, directoryId string, roleCollectionName string, configured roleCollectionMembers) (roleCollectionMembers, btpcli.CommandResponse, error) {
+	roleCollection, rawRes, err := rs.cli.Security.RoleCollection.GetByDirectoryWithAttributeMappings(ctx, directoryId, roleCollectionName)
+	if err != nil {
+		return roleCollectionMembers{}, rawRes, err
+	}
+
+	users, rawRes, err := rs.cli.Security.RoleCollection.GetUserAssignmentsByDirectory(ctx, directoryId, roleCollectionName)
+	if err != nil {
+		return roleCollectionMembers{}, rawRes, err
+	}
+
+	return roleCollectionMembersFromValue(users, roleCollection, configured), rawRes, nil
+}
+
+// updateMembers assigns the desired members that are missing and removes the assigned members that are not desired
+func (rs *directoryRoleCollectionMembersResource) updateMembers(ctx context.Context, directoryId string, roleCollectionName string, desired roleCollectionMembers) error {
+	current, _, err := rs.readMembers(ctx, directoryId, roleCollectionName, desired)
+	if err != nil {
+		return err
+	}
+
+	toBeRemoved := roleCollectionMembersDifference(current, desired)
+	toBeAdded := roleCollectionMembersDifference(desired, current)
+
+	for _, user := range toBeRemoved.Users {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignUserByDirectory(ctx, directoryId, roleCollectionName, user.Username.ValueString(), user.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range toBeRemoved.Groups {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignGroupByDirectory(ctx, directoryId, roleCollectionName, group.Groupname.ValueString(), group.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, attribute := range toBeRemoved.Attributes {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignAttributeByDirectory(ctx, directoryId, roleCollectionName, attribute.AttributeName.ValueString(), attribute.AttributeValue.ValueString(), attribute.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range toBeAdded.Users {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignUserByDirectory(ctx, directoryId, roleCollectionName, user.Username.ValueString(), user.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range toBeAdded.Groups {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignGroupByDirectory(ctx, directoryId, roleCollectionName, group.Groupname.ValueString(), group.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, attribute := range toBeAdded.Attributes {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignAttributeByDirectory(ctx, directoryId, roleCollectionName, attribute.AttributeName.ValueString(), attribute.AttributeValue.ValueString(), attribute.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
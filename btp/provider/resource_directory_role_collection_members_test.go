@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_authz"
+)
+
+func TestResourceDirectoryRoleCollectionMembers(t *testing.T) {
+	t.Run("happy path - create, update, import and delete", func(t *testing.T) {
+		// an assignment made outside of Terraform
+		fake := &roleCollectionMembersServerForTest{
+			users: []xsuaa_authz.UserReference{{Username: "jane.doe@test.com", Origin: "sap.default"}},
+		}
+
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if params["directory"] != "05368777-4934-41e8-9f3c-6ec5f4d564b9" || params["roleCollectionName"] != "Directory Viewer" {
+				return ""
+			}
+			return fake.handle(command, action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceDirectoryRoleCollectionMembers("uut", "05368777-4934-41e8-9f3c-6ec5f4d564b9", "Directory Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  groups = [{ group_name = "admins", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "id", "05368777-4934-41e8-9f3c-6ec5f4d564b9,Directory Viewer"),
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "groups.#", "1"),
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "attributes.#", "0"),
+						// the assignment made outside of Terraform is removed
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Groups", AttributeValue: "admins", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("btp_directory_role_collection_members.uut", map[string]knownvalue.Check{
+							"directory_id":         knownvalue.StringExact("05368777-4934-41e8-9f3c-6ec5f4d564b9"),
+							"role_collection_name": knownvalue.StringExact("Directory Viewer"),
+						}),
+					},
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceDirectoryRoleCollectionMembers("uut", "05368777-4934-41e8-9f3c-6ec5f4d564b9", "Directory Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  attributes = [{ attribute_name = "Department", attribute_value = "IT", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "groups.#", "0"),
+						resource.TestCheckResourceAttr("btp_directory_role_collection_members.uut", "attributes.#", "1"),
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Department", AttributeValue: "IT", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+				},
+				{
+					ResourceName:    "btp_directory_role_collection_members.uut",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+			},
+		})
+
+		// all members are unassigned on destroy
+		assert.Empty(t, fake.users)
+		assert.Empty(t, fake.mappings)
+	})
+
+	t.Run("error path - directory_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceDirectoryRoleCollectionMembers("uut", "this-is-not-a-uuid", "Directory Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]`),
+					ExpectError: regexp.MustCompile(`Attribute directory_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - user_name too long", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceDirectoryRoleCollectionMembers("uut", "05368777-4934-41e8-9f3c-6ec5f4d564b9", "Directory Viewer", fmt.Sprintf(`users = [{ user_name = "%s", origin = "ldap" }]`, strings.Repeat("a", 257))),
+					ExpectError: regexp.MustCompile(`Attribute users\[.*\].user_name string length must be between 1 and 256`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceDirectoryRoleCollectionMembers(resourceName string, directoryId string, roleCollectionName string, members string) string {
+	return fmt.Sprintf(`
+resource "btp_directory_role_collection_members" "%s" {
+  directory_id         = "%s"
+  role_collection_name = "%s"
+  %s
+}`, resourceName, directoryId, roleCollectionName, members)
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newDirectorySecuritySettingsResource() resource.Resource {
+	return &directorySecuritySettingsResource{}
+}
+
+type directorySecuritySettingsResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *directorySecuritySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_directory_security_settings", req.ProviderTypeName)
+}
+
+func (rs *directorySecuritySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *directorySecuritySettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Sets the security settings of a directory.
+
+__Tip:__
+You must be assigned to the admin role of the global account or the directory.
+
+__Note:__
+The directory must be configured to manage its authorizations, i.e. its ` + "`features`" + ` must contain ` + "`AUTHORIZATIONS`" + `.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/configure-trusted-domains-for-sap-authorization-and-trust-management-service>
+<https://help.sap.com/docs/btp/sap-business-technology-platform/configure-token-policy-for-sap-authorization-and-trust-management-service>`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_email_domains": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of domains that are allowed to be used for user authentication.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"default_identity_provider": schema.StringAttribute{
+				MarkdownDescription: "The directory's default identity provider for platform users.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sap.default"),
+			},
+			"treat_users_with_same_email_as_same_user": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, users with the same email are treated as same users.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"access_token_validity": schema.Int64Attribute{
+				MarkdownDescription: "The validity of the access token.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(int64(-1)),
+			},
+			"refresh_token_validity": schema.Int64Attribute{
+				MarkdownDescription: "The validity of the refresh token.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(int64(-1)),
+			},
+			"iframe_domains_list": schema.ListAttribute{
+				MarkdownDescription: "The new domains of the iframe. Enter as list.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^([^ ]{4,})$`), "the attribute iframe_domains_list must contain valid domains."),
+					),
+				},
+			},
+			"use_idp_user_name_in_tokens": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, the user name from the identity provider is used in the tokens.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"auto_rotate_signing_key": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, the signing key for access tokens is rotated automatically.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type directorySecuritySettingsResourceIdentityModel struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+}
+
+func (rs *directorySecuritySettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"directory_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *directorySecuritySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directorySecuritySettingsType
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Security.Settings.ListByDirectory(ctx, state.DirectoryId.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Security Settings (Directory)")
+		return
+	}
+
+	updatedState, diags := directorySecuritySettingsValueFrom(ctx, state.DirectoryId, cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &updatedState)
+	resp.Diagnostics.Append(diags...)
+
+	var identity directorySecuritySettingsResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		identity = directorySecuritySettingsResourceIdentityModel{
+			DirectoryId: updatedState.DirectoryId,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (rs *directorySecuritySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directorySecuritySettingsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var customEmailDomains []string
+
+	diags = plan.CustomEmailDomains.ElementsAs(ctx, &customEmailDomains, false)
+	resp.Diagnostics.Append(diags...)
+
+	iFrameDomains, diags := joinIframeDomainsList(ctx, plan.IframeDomainsList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, _, err := rs.cli.Security.Settings.UpdateByDirectory(ctx, plan.DirectoryId.ValueString(), btpcli.SecuritySettingsUpdateInput{
+		CustomEmail:                       customEmailDomains,
+		DefaultIDPForNonInteractiveLogon:  plan.DefaultIdentityProvider.ValueString(),
+		TreatUsersWithSameEmailAsSameUser: plan.TreatUsersWithSameEmailAsSameUser.ValueBool(),
+		AccessTokenValidity:               int(plan.AccessTokenValidity.ValueInt64()),
+		RefreshTokenValidity:              int(plan.RefreshTokenValidity.ValueInt64()),
+		IFrame:                            iFrameDomains,
+		UseIdpUserNameInTokens:            plan.UseIdpUserNameInTokens.ValueBool(),
+		AutoRotateSigningKey:              plan.AutoRotateSigningKey.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Security Settings (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	state, diags := directorySecuritySettingsValueFrom(ctx, plan.DirectoryId, res)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := directorySecuritySettingsResourceIdentityModel{
+		DirectoryId: state.DirectoryId,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directorySecuritySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan directorySecuritySettingsType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	var state directorySecuritySettingsType
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var customEmailDomains []string
+	diags = plan.CustomEmailDomains.ElementsAs(ctx, &customEmailDomains, false)
+	resp.Diagnostics.Append(diags...)
+
+	planIFrameDomains, diags := joinIframeDomainsList(ctx, plan.IframeDomainsList)
+	resp.Diagnostics.Append(diags...)
+
+	stateIFrameDomains, diags := joinIframeDomainsList(ctx, state.IframeDomainsList)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IframeDomainsList.IsUnknown() {
+		// the iframe domains are not part of the configuration, so we keep the current value
+		planIFrameDomains = stateIFrameDomains
+	}
+
+	res, _, err := rs.cli.Security.Settings.UpdateByDirectory(ctx, plan.DirectoryId.ValueString(), btpcli.SecuritySettingsUpdateInput{
+		CustomEmail:                       customEmailDomains,
+		DefaultIDPForNonInteractiveLogon:  plan.DefaultIdentityProvider.ValueString(),
+		TreatUsersWithSameEmailAsSameUser: plan.TreatUsersWithSameEmailAsSameUser.ValueBool(),
+		AccessTokenValidity:               int(plan.AccessTokenValidity.ValueInt64()),
+		RefreshTokenValidity:              int(plan.RefreshTokenValidity.ValueInt64()),
+		IFrame:                            transformIframeDomain(planIFrameDomains, stateIFrameDomains),
+		UseIdpUserNameInTokens:            plan.UseIdpUserNameInTokens.ValueBool(),
+		AutoRotateSigningKey:              plan.AutoRotateSigningKey.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Security Settings (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	state, diags = directorySecuritySettingsValueFrom(ctx, plan.DirectoryId, res)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	// WORKAROUND for OpenTofu compatibility
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	identity := directorySecuritySettingsResourceIdentityModel{
+		DirectoryId: state.DirectoryId,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	// END WORKAROUND
+}
+
+func (rs *directorySecuritySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directorySecuritySettingsType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Security.Settings.UpdateByDirectory(ctx, state.DirectoryId.ValueString(), btpcli.SecuritySettingsUpdateInput{
+		CustomEmail:                       []string{},
+		DefaultIDPForNonInteractiveLogon:  "sap.default",
+		TreatUsersWithSameEmailAsSameUser: false,
+		AccessTokenValidity:               -1,
+		RefreshTokenValidity:              -1,
+		IFrame:                            " ", // The string should be empty, however to do the update the value must be " " (space)
+		UseIdpUserNameInTokens:            false,
+		AutoRotateSigningKey:              false,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Security Settings (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *directorySecuritySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), req.ID)...)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("directory_id"), path.Root("directory_id"), req, resp)
+}
+
+func joinIframeDomainsList(ctx context.Context, iframeDomainsList types.List) (string, diag.Diagnostics) {
+	if iframeDomainsList.IsNull() || iframeDomainsList.IsUnknown() {
+		return "", nil
+	}
+
+	var domains []string
+	diags := iframeDomainsList.ElementsAs(ctx, &domains, false)
+
+	return strings.Join(domains, " "), diags
+}
@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newDirectoryTrustConfigurationResource() resource.Resource {
+	return &directoryTrustConfigurationResource{}
+}
+
+type directoryTrustConfigurationResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *directoryTrustConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_directory_trust_configuration", req.ProviderTypeName)
+}
+
+func (rs *directoryTrustConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *directoryTrustConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Establishes trust from a directory to an Identity Authentication tenant.
+
+__Tip:__
+You must be assigned to the admin role of the global account or the directory.
+
+__Note:__
+The directory must be configured to manage its authorizations, i.e. its ` + "`features`" + ` must contain ` + "`AUTHORIZATIONS`" + `.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/trust-and-federation-with-identity-providers>`,
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_provider": schema.StringAttribute{
+				MarkdownDescription: "The name of the Identity Authentication tenant that you want to connect to the directory.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The tenant's domain which should be used for user logon.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the trust configuration.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the trust configuration.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link_text": schema.StringAttribute{
+				MarkdownDescription: "Short string that helps users to identify the link for login.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"available_for_user_logon": schema.BoolAttribute{
+				MarkdownDescription: "Determines that end users can choose the trust configuration for login. If not set, the trust configuration can remain active, however only application users that explicitly specify the origin key can use if for login.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auto_create_shadow_users": schema.BoolAttribute{
+				MarkdownDescription: "Determines that any user from the tenant can log in. If not set, only the ones who already have a shadow user can log in.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Determines whether the identity provider is currently 'active' or 'inactive'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "inactive"),
+				},
+			},
+			"origin": schema.StringAttribute{
+				MarkdownDescription: "The origin of the identity provider.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^.{1,27}-platform$`), "must end with '-platform' and not exceed 36 characters"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				DeprecationMessage:  "Use the `origin` attribute instead",
+				MarkdownDescription: "The origin of the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The trust type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol used to establish trust with the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the trust configuration can be modified.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type directoryTrustConfigurationResourceIdentityModel struct {
+	DirectoryId types.String `tfsdk:"directory_id"`
+	Origin      types.String `tfsdk:"origin"`
+}
+
+func (rs *directoryTrustConfigurationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"directory_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"origin": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *directoryTrustConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryTrustConfigurationType
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Security.Trust.GetByDirectory(ctx, state.DirectoryId.ValueString(), state.Origin.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Trust Configuration (Directory)")
+		return
+	}
+
+	state, diags = directoryTrustConfigurationFromValue(ctx, state.DirectoryId.ValueString(), cliRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity directoryTrustConfigurationResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		identity = directoryTrustConfigurationResourceIdentityModel{
+			DirectoryId: state.DirectoryId,
+			Origin:      state.Origin,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (rs *directoryTrustConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryTrustConfigurationType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliCreateReq := btpcli.TrustConfigurationCreateInput{
+		IdentityProvider: plan.IdentityProvider.ValueString(),
+	}
+
+	if !plan.Name.IsUnknown() {
+		name := plan.Name.ValueString()
+		cliCreateReq.Name = &name
+	}
+
+	if !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		cliCreateReq.Description = &description
+	}
+
+	if !plan.Domain.IsUnknown() {
+		domain := plan.Domain.ValueString()
+		cliCreateReq.Domain = &domain
+	}
+
+	if !plan.Origin.IsUnknown() {
+		origin := plan.Origin.ValueString()
+		cliCreateReq.Origin = &origin
+	}
+
+	if !plan.LinkText.IsUnknown() {
+		linkText := plan.LinkText.ValueString()
+		cliCreateReq.LinkText = &linkText
+	}
+
+	availableForUserLogon := plan.AvailableForUserLogon.ValueBool()
+	cliCreateReq.AvailableForUserLogon = &availableForUserLogon
+	autoCreateShadowUsers := plan.AutoCreateShadowUsers.ValueBool()
+	cliCreateReq.AutoCreateShadowUsers = &autoCreateShadowUsers
+
+	createRes, _, err := rs.cli.Security.Trust.CreateByDirectory(ctx, plan.DirectoryId.ValueString(), cliCreateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Trust Configuration (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// Status cannot be set via create request, so we need to update the trust configuration after creation
+	// We transfer all values to avoid side effects of empty values for optional fields in the update request.
+	status := plan.Status.ValueString()
+	cliUpdateReq := btpcli.TrustConfigurationUpdateInput{
+		OriginKey: createRes.OriginKey,
+		// TODO: remove repeating domain and idp, see NGPBUG-364505
+		IdentityProvider:      &cliCreateReq.IdentityProvider,
+		Name:                  cliCreateReq.Name,
+		Description:           cliCreateReq.Description,
+		Domain:                cliCreateReq.Domain,
+		LinkText:              cliCreateReq.LinkText,
+		AvailableForUserLogon: &availableForUserLogon,
+		AutoCreateShadowUsers: &autoCreateShadowUsers,
+		Status:                &status,
+	}
+
+	_, _, err = rs.cli.Security.Trust.UpdateByDirectory(ctx, plan.DirectoryId.ValueString(), cliUpdateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration after Creation (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	getRes, _, err := rs.cli.Security.Trust.GetByDirectory(ctx, plan.DirectoryId.ValueString(), createRes.OriginKey)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Creation (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	state, diags := directoryTrustConfigurationFromValue(ctx, plan.DirectoryId.ValueString(), getRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := directoryTrustConfigurationResourceIdentityModel{
+		DirectoryId: state.DirectoryId,
+		Origin:      state.Origin,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directoryTrustConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan directoryTrustConfigurationType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idp := plan.IdentityProvider.ValueString()
+	availableForUserLogon := plan.AvailableForUserLogon.ValueBool()
+	autoCreateShadowUsers := plan.AutoCreateShadowUsers.ValueBool()
+	status := plan.Status.ValueString()
+	cliUpdateReq := btpcli.TrustConfigurationUpdateInput{
+		OriginKey:             plan.Origin.ValueString(),
+		IdentityProvider:      &idp,
+		AvailableForUserLogon: &availableForUserLogon,
+		AutoCreateShadowUsers: &autoCreateShadowUsers,
+		Status:                &status,
+	}
+
+	if !plan.Name.IsUnknown() {
+		name := plan.Name.ValueString()
+		cliUpdateReq.Name = &name
+	}
+
+	if !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		cliUpdateReq.Description = &description
+	}
+
+	if !plan.Domain.IsUnknown() {
+		domain := plan.Domain.ValueString()
+		cliUpdateReq.Domain = &domain
+	}
+
+	if !plan.LinkText.IsUnknown() {
+		linkText := plan.LinkText.ValueString()
+		cliUpdateReq.LinkText = &linkText
+	}
+
+	updateRes, _, err := rs.cli.Security.Trust.UpdateByDirectory(ctx, plan.DirectoryId.ValueString(), cliUpdateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Trust Configuration (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	getRes, _, err := rs.cli.Security.Trust.GetByDirectory(ctx, plan.DirectoryId.ValueString(), updateRes.OriginKey)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Trust Configuration after Update (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+	state, diags := directoryTrustConfigurationFromValue(ctx, plan.DirectoryId.ValueString(), getRes)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	identity := directoryTrustConfigurationResourceIdentityModel{
+		DirectoryId: state.DirectoryId,
+		Origin:      state.Origin,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *directoryTrustConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directoryTrustConfigurationType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Security.Trust.DeleteByDirectory(ctx, state.DirectoryId.ValueString(), state.Origin.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Trust Configuration (Directory)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *directoryTrustConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: directory_id,origin. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), idParts[1])...)
+		return
+	}
+
+	var identity directoryTrustConfigurationResourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("directory_id"), identity.DirectoryId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), identity.Origin)...)
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_trust"
+)
+
+func TestResourceDirectoryTrustConfiguration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path - create and update", func(t *testing.T) {
+		t.Parallel()
+		fake := &directoryTrustConfigurationServerForTest{}
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if command != "security/trust" || params["directory"] != "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d" {
+				return ""
+			}
+			return fake.handle(action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceDirectoryTrustConfiguration("uut", "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d", "terraformint.accounts400.ondemand.com", `link_text = "Log on with my IdP"
+  status = "inactive"`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "origin", "terraformint-platform"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "link_text", "Log on with my IdP"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "available_for_user_logon", "true"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "auto_create_shadow_users", "true"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "status", "inactive"),
+					),
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceDirectoryTrustConfiguration("uut", "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d", "terraformint.accounts400.ondemand.com", `link_text = "Log on with my IdP"
+  available_for_user_logon = false
+  auto_create_shadow_users = false`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "available_for_user_logon", "false"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "auto_create_shadow_users", "false"),
+						resource.TestCheckResourceAttr("btp_directory_trust_configuration.uut", "status", "active"),
+					),
+				},
+			},
+		})
+
+		assert.Nil(t, fake.trust)
+	})
+
+	t.Run("error path - directory_id mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `resource "btp_directory_trust_configuration" "uut" { identity_provider = "terraformint.accounts400.ondemand.com" }`,
+					ExpectError: regexp.MustCompile(`The argument "directory_id" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - directory_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `resource "btp_directory_trust_configuration" "uut" { directory_id = "this-is-not-a-uuid" ` + "\n" + `identity_provider = "terraformint.accounts400.ondemand.com" }`,
+					ExpectError: regexp.MustCompile(`Attribute directory_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - origin must end with -platform", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `resource "btp_directory_trust_configuration" "uut" { directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d" ` + "\n" + `identity_provider = "terraformint.accounts400.ondemand.com" ` + "\n" + `origin = "my-origin" }`,
+					ExpectError: regexp.MustCompile(`must end with '-platform'`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceDirectoryTrustConfiguration(resourceName string, directoryId string, identityProvider string, attributes string) string {
+	return fmt.Sprintf(`
+resource "btp_directory_trust_configuration" "%s" {
+  directory_id      = "%s"
+  identity_provider = "%s"
+  %s
+}`, resourceName, directoryId, identityProvider, attributes)
+}
+
+// directoryTrustConfigurationServerForTest keeps a single trust configuration like the CLI server does for the commands
+// to create, read, update and delete it
+type directoryTrustConfigurationServerForTest struct {
+	mutex sync.Mutex
+	trust *xsuaa_trust.TrustConfigurationResponseObject
+}
+
+func (srv *directoryTrustConfigurationServerForTest) handle(action string, params map[string]any) string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	param := func(key string) (string, bool) {
+		value, ok := params[key].(string)
+		return value, ok
+	}
+
+	update := func(trust *xsuaa_trust.TrustConfigurationResponseObject) {
+		if value, ok := param("linkText"); ok {
+			trust.LinkTextForUserLogon = value
+		}
+		if value, ok := param("userLogon"); ok {
+			trust.AvailableForUserLogon = value
+		}
+		if value, ok := param("shadowUsers"); ok {
+			trust.CreateShadowUsersDuringLogon = value
+		}
+		if value, ok := param("status"); ok {
+			trust.Status = value
+		}
+	}
+
+	var response any
+	switch action {
+	case "create":
+		identityProvider, _ := param("iasTenantUrl")
+		srv.trust = &xsuaa_trust.TrustConfigurationResponseObject{
+			Name:             identityProvider,
+			OriginKey:        "terraformint-platform",
+			TypeOfTrust:      "Application",
+			Status:           "active",
+			IdentityProvider: identityProvider,
+			Protocol:         "OpenID Connect",
+		}
+		update(srv.trust)
+		response = xsuaa_trust.ModifyTrustConfigurationResponseObject{OriginKey: srv.trust.OriginKey}
+	case "get", "update":
+		if srv.trust == nil {
+			return cliServerBackendNotFoundForTest
+		}
+		if action == "update" {
+			update(srv.trust)
+		}
+		response = srv.trust
+	case "delete":
+		srv.trust = nil
+		response = xsuaa_trust.ModifyTrustConfigurationResponseObject{OriginKey: "terraformint-platform"}
+	default:
+		return ""
+	}
+
+	body, _ := json.Marshal(response)
+	return string(body)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func newDisasterRecoverySubaccountPairResource() resource.Resource {
@@ -36,7 +38,7 @@ func (rs *disasterRecoverySubaccountPairResource) Configure(_ context.Context, r
 	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
 }
 
-func (rs *disasterRecoverySubaccountPairResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (rs *disasterRecoverySubaccountPairResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Create a subaccount pair for the specified subaccounts.
 
@@ -90,6 +92,14 @@ __Further documentation:__
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the subaccount pair.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the subaccount pair.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the subaccount pair.",
+			}),
 		},
 	}
 }
@@ -127,9 +137,12 @@ func (rs *disasterRecoverySubaccountPairResource) Read(ctx context.Context, req
 		return
 	}
 
+	timeoutsLocal := data.Timeouts
 	data, diags = disasterRecoverySubaccountPairValueFrom(ctx, data.SubaccountId, data.PairedSubaccountId, cliRes)
 	resp.Diagnostics.Append(diags...)
 
+	data.Timeouts = timeoutsLocal
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
@@ -155,6 +168,13 @@ func (rs *disasterRecoverySubaccountPairResource) Create(ctx context.Context, re
 		return
 	}
 
+	// The pairing is processed synchronously, so the timeout applies to the API calls
+	createTimeout, diags := plan.Timeouts.Create(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	_, _, err := rs.cli.DisasterRecovery.SubaccountPair.Create(ctx, &btpcli.SubaccountPairCreateInput{
 		SubaccountId:     plan.SubaccountId.ValueString(),
 		WithSubaccountId: plan.PairedSubaccountId.ValueString(),
@@ -173,6 +193,8 @@ func (rs *disasterRecoverySubaccountPairResource) Create(ctx context.Context, re
 	data, diags := disasterRecoverySubaccountPairValueFrom(ctx, plan.SubaccountId, plan.PairedSubaccountId, subaccountPairData)
 	resp.Diagnostics.Append(diags...)
 
+	data.Timeouts = plan.Timeouts
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
@@ -186,7 +208,7 @@ func (rs *disasterRecoverySubaccountPairResource) Create(ctx context.Context, re
 }
 
 func (rs *disasterRecoverySubaccountPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan DisasterRecoverySubaccountPairType
+	var plan, state DisasterRecoverySubaccountPairType
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -194,11 +216,33 @@ func (rs *disasterRecoverySubaccountPairResource) Update(ctx context.Context, re
 		return
 	}
 
-	resp.Diagnostics.AddError("API Error Updating Disaster Recovery Subaccount Pair", "This resource is not supposed to be updated")
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !plan.Timeouts.Equal(state.Timeouts) {
+		// All other attributes require a replacement, so only the timeouts can change
+		state.Timeouts = plan.Timeouts
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		// WORKAROUND for OpenTofu compatibility
+		// see https://github.com/SAP/terraform-provider-btp/issues/1383
+		identity := DisasterRecoverySubaccountPairResourceIdentityModel{
+			SubaccountId:       state.SubaccountId,
+			PairedSubaccountId: state.PairedSubaccountId,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+		// END WORKAROUND
+		return
+	}
+
+	resp.Diagnostics.AddError("API Error Updating Disaster Recovery Subaccount Pair", "This resource is not supposed to be updated")
 }
 
 func (rs *disasterRecoverySubaccountPairResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -209,6 +253,12 @@ func (rs *disasterRecoverySubaccountPairResource) Delete(ctx context.Context, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	_, _, err := rs.cli.DisasterRecovery.SubaccountPair.Delete(ctx, state.SubaccountId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("API Error deleting subaccount pair", fmt.Sprintf("%s", err))
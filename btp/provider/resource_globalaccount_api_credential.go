@@ -9,9 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
 	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
@@ -108,6 +111,20 @@ __Further documentation:__
 	}
 }
 
+type globalaccountApiCredentialResourceIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (rs *globalaccountApiCredentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (rs *globalaccountApiCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan globalaccountApiCredentialType
 	diags := req.Plan.Get(ctx, &plan)
@@ -134,6 +151,12 @@ func (rs *globalaccountApiCredentialResource) Create(ctx context.Context, req re
 
 	diags = resp.State.Set(ctx, &updatedPlan)
 	resp.Diagnostics.Append(diags...)
+
+	identity := globalaccountApiCredentialResourceIdentityModel{
+		Name: updatedPlan.Name,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (rs *globalaccountApiCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -168,6 +191,17 @@ func (rs *globalaccountApiCredentialResource) Read(ctx context.Context, req reso
 
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
+
+	var identity globalaccountApiCredentialResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.Name.IsNull() {
+		identity = globalaccountApiCredentialResourceIdentityModel{
+			Name: newState.Name,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
 }
 
 func (rs *globalaccountApiCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -191,3 +225,12 @@ func (rs *globalaccountApiCredentialResource) Delete(ctx context.Context, req re
 		return
 	}
 }
+
+func (rs *globalaccountApiCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -130,6 +131,51 @@ You must be assigned to the admin role of the global account.`,
 	}
 }
 
+type globalaccountRoleCollectionAssignmentResourceIdentityModel struct {
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+	Username           types.String `tfsdk:"user_name"`
+	Groupname          types.String `tfsdk:"group_name"`
+	AttributeName      types.String `tfsdk:"attribute_name"`
+	AttributeValue     types.String `tfsdk:"attribute_value"`
+	Origin             types.String `tfsdk:"origin"`
+}
+
+func globalaccountRoleCollectionAssignmentIdentityFrom(data globalaccountRoleCollectionAssignmentType) globalaccountRoleCollectionAssignmentResourceIdentityModel {
+	return globalaccountRoleCollectionAssignmentResourceIdentityModel{
+		RoleCollectionName: data.RoleCollectionName,
+		Username:           data.Username,
+		Groupname:          data.Groupname,
+		AttributeName:      data.AttributeName,
+		AttributeValue:     data.AttributeValue,
+		Origin:             data.Origin,
+	}
+}
+
+func (rs *globalaccountRoleCollectionAssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"role_collection_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"user_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"group_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"attribute_name": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"attribute_value": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"origin": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
 func (rs *globalaccountRoleCollectionAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state globalaccountRoleCollectionAssignmentType
 
@@ -139,45 +185,67 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Read(ctx context.Contex
 		return
 	}
 
+	isAssigned, err := rs.isAssigned(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Global Account)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	if !isAssigned {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Id.IsNull() {
+		// Setting ID of state - required by hashicorps terraform plugin testing framework for Import. See issue https://github.com/hashicorp/terraform-plugin-testing/issues/84
+		state.Id = types.StringValue(fmt.Sprintf("%s,%s", state.RoleCollectionName.ValueString(), state.Username.ValueString()))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity globalaccountRoleCollectionAssignmentResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.RoleCollectionName.IsNull() {
+		identity = globalaccountRoleCollectionAssignmentIdentityFrom(state)
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+// isAssigned checks whether the user, group or attribute of the state is assigned to the role collection
+func (rs *globalaccountRoleCollectionAssignmentResource) isAssigned(ctx context.Context, state globalaccountRoleCollectionAssignmentType) (bool, error) {
 	if !state.Username.IsNull() {
 		users, _, err := rs.cli.Security.RoleCollection.GetUserAssignmentsByGlobalAccount(ctx, state.RoleCollectionName.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Global Account)", fmt.Sprintf("%s", err))
-			return
+			return false, err
 		}
 		for _, u := range users {
 			if (u.Username == state.Username.ValueString() || u.Email == state.Username.ValueString()) && originMatches(u.Origin, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		}
-		resp.State.RemoveResource(ctx)
-		return
+		return false, nil
 	}
 
 	cliRes, _, err := rs.cli.Security.RoleCollection.GetByGlobalAccountWithAttributeMapings(ctx, state.RoleCollectionName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Role Collection Assignment (Global Account)", fmt.Sprintf("%s", err))
-		return
+		return false, err
 	}
 
 	for _, am := range cliRes.SamlAttributeAssignment {
 		if !state.Groupname.IsNull() {
 			if am.AttributeName == "Groups" && am.AttributeValue == state.Groupname.ValueString() && originMatches(am.IdentityProvider, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		} else {
 			if am.AttributeName == state.AttributeName.ValueString() && am.AttributeValue == state.AttributeValue.ValueString() && originMatches(am.IdentityProvider, state.Origin.ValueString()) {
-				diags = resp.State.Set(ctx, &state)
-				resp.Diagnostics.Append(diags...)
-				return
+				return true, nil
 			}
 		}
 	}
-	resp.State.RemoveResource(ctx)
+	return false, nil
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -210,6 +278,8 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Create(ctx context.Cont
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, globalaccountRoleCollectionAssignmentIdentityFrom(plan))...)
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -257,8 +327,28 @@ func (rs *globalaccountRoleCollectionAssignmentResource) Delete(ctx context.Cont
 }
 
 func (rs *globalaccountRoleCollectionAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.AddError(
-		"Import Not Supported",
-		"Import is not supported for this resource. Use the resource globalaccount_role_collection instead.",
-	)
+	if req.ID != "" {
+		resp.Diagnostics.AddError(
+			"Import Not Supported",
+			"Import by ID is not supported for this resource. Use an import block with the identity of the role collection assignment instead, e.g. as returned by the list resource btp_globalaccount_role_collection_assignment.",
+		)
+		return
+	}
+
+	var identityData globalaccountRoleCollectionAssignmentResourceIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identityData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if identityData.Origin.IsNull() {
+		identityData.Origin = types.StringValue("ldap")
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_collection_name"), identityData.RoleCollectionName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), identityData.Username)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), identityData.Groupname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_name"), identityData.AttributeName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_value"), identityData.AttributeValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("origin"), identityData.Origin)...)
 }
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+)
+
+func newGlobalaccountRoleCollectionMembersResource() resource.Resource {
+	return &globalaccountRoleCollectionMembersResource{}
+}
+
+type globalaccountRoleCollectionMembersType struct {
+	Id                 types.String                        `tfsdk:"id"`
+	RoleCollectionName types.String                        `tfsdk:"role_collection_name"`
+	Users              []roleCollectionMemberUserType      `tfsdk:"users"`
+	Groups             []roleCollectionMemberGroupType     `tfsdk:"groups"`
+	Attributes         []roleCollectionMemberAttributeType `tfsdk:"attributes"`
+}
+
+func (data *globalaccountRoleCollectionMembersType) members() roleCollectionMembers {
+	return roleCollectionMembers{
+		Users:      data.Users,
+		Groups:     data.Groups,
+		Attributes: data.Attributes,
+	}
+}
+
+func (data *globalaccountRoleCollectionMembersType) setMembers(members roleCollectionMembers) {
+	data.Users = members.Users
+	data.Groups = members.Groups
+	data.Attributes = members.Attributes
+}
+
+type globalaccountRoleCollectionMembersResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_globalaccount_role_collection_members", req.ProviderTypeName)
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"role_collection_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role collection.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The name of the role collection.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	maps.Copy(attributes, roleCollectionMembersAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages all users, groups and attribute mappings that are assigned to a role collection on global account level. The resource is authoritative: assignments that are not part of the configuration are removed, including assignments made outside of Terraform.
+
+__Tip:__
+You must be assigned to the admin role of the global account.
+
+__Notes:__
+- Do not combine this resource with the resource ` + "`btp_globalaccount_role_collection_assignment`" + ` for the same role collection, as both would manage the same assignments.
+- Omitting one of the attributes ` + "`users`" + `, ` + "`groups`" + ` or ` + "`attributes`" + ` removes all assignments of this kind.
+- Destroying the resource removes all assignments from the role collection. The role collection itself is not deleted.`,
+		Attributes: attributes,
+	}
+}
+
+type globalaccountRoleCollectionMembersResourceIdentityModel struct {
+	RoleCollectionName types.String `tfsdk:"role_collection_name"`
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"role_collection_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalaccountRoleCollectionMembersType
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, rawRes, err := rs.readMembers(ctx, state.RoleCollectionName.ValueString(), state.members())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, nil, resp, err, "Resource Role Collection Members (Global Account)")
+		return
+	}
+
+	state.Id = state.RoleCollectionName
+	state.setMembers(members)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity globalaccountRoleCollectionMembersResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.RoleCollectionName.IsNull() {
+		identity = globalaccountRoleCollectionMembersResourceIdentityModel{
+			RoleCollectionName: state.RoleCollectionName,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalaccountRoleCollectionMembersType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, plan.RoleCollectionName.ValueString(), plan.members())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Role Collection Members (Global Account)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	plan.Id = plan.RoleCollectionName
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := globalaccountRoleCollectionMembersResourceIdentityModel{
+		RoleCollectionName: plan.RoleCollectionName,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan globalaccountRoleCollectionMembersType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, plan.RoleCollectionName.ValueString(), plan.members())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Role Collection Members (Global Account)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	plan.Id = plan.RoleCollectionName
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := globalaccountRoleCollectionMembersResourceIdentityModel{
+		RoleCollectionName: plan.RoleCollectionName,
+	}
+
+	// Set Identity for OpenTofu compatibility also during Update
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalaccountRoleCollectionMembersType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := rs.updateMembers(ctx, state.RoleCollectionName.ValueString(), roleCollectionMembers{})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Role Collection Members (Global Account)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *globalaccountRoleCollectionMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("role_collection_name"), req, resp)
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("role_collection_name"), path.Root("role_collection_name"), req, resp)
+}
+
+// readMembers fetches the users, groups and attribute mappings that are currently assigned to the role collection
+func (rs *globalaccountRoleCollectionMembersResource) readMembers(ctx context.Context, roleCollectionName string, configured roleCollectionMembers) (roleCollectionMembers, btpcli.CommandResponse, error) {
+	roleCollection, rawRes, err := rs.cli.Security.RoleCollection.GetByGlobalAccountWithAttributeMapings(ctx, roleCollectionName)
+	if err != nil {
+		return roleCollectionMembers{}, rawRes, err
+	}
+
+	users, rawRes, err := rs.cli.Security.RoleCollection.GetUserAssignmentsByGlobalAccount(ctx, roleCollectionName)
+	if err != nil {
+		return roleCollectionMembers{}, rawRes, err
+	}
+
+	return roleCollectionMembersFromValue(users, roleCollection, configured), rawRes, nil
+}
+
+// updateMembers assigns the desired members that are missing and removes the assigned members that are not desired
+func (rs *globalaccountRoleCollectionMembersResource) updateMembers(ctx context.Context, roleCollectionName string, desired roleCollectionMembers) error {
+	current, _, err := rs.readMembers(ctx, roleCollectionName, desired)
+	if err != nil {
+		return err
+	}
+
+	toBeRemoved := roleCollectionMembersDifference(current, desired)
+	toBeAdded := roleCollectionMembersDifference(desired, current)
+
+	for _, user := range toBeRemoved.Users {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignUserByGlobalaccount(ctx, roleCollectionName, user.Username.ValueString(), user.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range toBeRemoved.Groups {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignGroupByGlobalaccount(ctx, roleCollectionName, group.Groupname.ValueString(), group.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, attribute := range toBeRemoved.Attributes {
+		if _, _, err := rs.cli.Security.RoleCollection.UnassignAttributeByGlobalaccount(ctx, roleCollectionName, attribute.AttributeName.ValueString(), attribute.AttributeValue.ValueString(), attribute.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range toBeAdded.Users {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignUserByGlobalaccount(ctx, roleCollectionName, user.Username.ValueString(), user.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range toBeAdded.Groups {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignGroupByGlobalaccount(ctx, roleCollectionName, group.Groupname.ValueString(), group.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	for _, attribute := range toBeAdded.Attributes {
+		if _, _, err := rs.cli.Security.RoleCollection.AssignAttributeByGlobalaccount(ctx, roleCollectionName, attribute.AttributeName.ValueString(), attribute.AttributeValue.ValueString(), attribute.Origin.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_authz"
+)
+
+func TestResourceGlobalaccountRoleCollectionMembers(t *testing.T) {
+	t.Run("happy path - create, update, import and delete", func(t *testing.T) {
+		// an assignment made outside of Terraform
+		fake := &roleCollectionMembersServerForTest{
+			users: []xsuaa_authz.UserReference{{Username: "jane.doe@test.com", Origin: "sap.default"}},
+		}
+
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if params["globalAccount"] != testGlobalAccount || params["roleCollectionName"] != "Global Account Viewer" {
+				return ""
+			}
+			return fake.handle(command, action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceGlobalaccountRoleCollectionMembers("uut", "Global Account Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  groups = [{ group_name = "admins", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "id", "Global Account Viewer"),
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "groups.#", "1"),
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "attributes.#", "0"),
+						// the assignment made outside of Terraform is removed
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Groups", AttributeValue: "admins", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("btp_globalaccount_role_collection_members.uut", map[string]knownvalue.Check{
+							"role_collection_name": knownvalue.StringExact("Global Account Viewer"),
+						}),
+					},
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceGlobalaccountRoleCollectionMembers("uut", "Global Account Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  attributes = [{ attribute_name = "Department", attribute_value = "IT", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "groups.#", "0"),
+						resource.TestCheckResourceAttr("btp_globalaccount_role_collection_members.uut", "attributes.#", "1"),
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Department", AttributeValue: "IT", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+				},
+				{
+					ResourceName:    "btp_globalaccount_role_collection_members.uut",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+			},
+		})
+
+		// all members are unassigned on destroy
+		assert.Empty(t, fake.users)
+		assert.Empty(t, fake.mappings)
+	})
+
+	t.Run("error path - role_collection_name empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceGlobalaccountRoleCollectionMembers("uut", "", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]`),
+					ExpectError: regexp.MustCompile(`Attribute role_collection_name string length must be at least 1, got: 0`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - attribute_value empty", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceGlobalaccountRoleCollectionMembers("uut", "Global Account Viewer", `attributes = [{ attribute_name = "Department", attribute_value = "", origin = "terraformint-platform" }]`),
+					ExpectError: regexp.MustCompile(`Attribute attributes\[.*\].attribute_value string length must be at least 1`),
+				},
+			},
+		})
+	})
+}
+
+func hclResourceGlobalaccountRoleCollectionMembers(resourceName string, roleCollectionName string, members string) string {
+	return fmt.Sprintf(`
+resource "btp_globalaccount_role_collection_members" "%s" {
+  role_collection_name = "%s"
+  %s
+}`, resourceName, roleCollectionName, members)
+}
@@ -6,8 +6,8 @@ import (
 	"maps"
 	"net/http"
 	"regexp"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -45,7 +45,7 @@ func (rs *subaccountResource) Configure(_ context.Context, req resource.Configur
 	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
 }
 
-func (rs *subaccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (rs *subaccountResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Creates a subaccount in a global account or directory.
 
@@ -209,6 +209,14 @@ __Further documentation:__
 				MarkdownDescription: "Shows the contract status of the subaccount.",
 				Computed:            true,
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the subaccount.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the subaccount.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the subaccount.",
+			}),
 		},
 	}
 }
@@ -254,6 +262,8 @@ func (rs *subaccountResource) Read(ctx context.Context, req resource.ReadRequest
 		data.SkipAutoEntitlement = originalState.SkipAutoEntitlement
 	}
 
+	data.Timeouts = originalState.Timeouts
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
@@ -343,6 +353,10 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 	plan, diags = subaccountValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
+	createTimeout, diags := originalPlan.Timeouts.Create(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(createTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -358,9 +372,9 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 
 			return subRes, subRes.State, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    createTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	updatedRes, err := createStateConf.WaitForStateContext(ctx)
@@ -377,6 +391,8 @@ func (rs *subaccountResource) Create(ctx context.Context, req resource.CreateReq
 		plan.SkipAutoEntitlement = originalPlan.SkipAutoEntitlement
 	}
 
+	plan.Timeouts = originalPlan.Timeouts
+
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -428,9 +444,14 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	timeoutsLocal := plan.Timeouts
 	plan, diags = subaccountValueFrom(ctx, cliRes)
 	resp.Diagnostics.Append(diags...)
 
+	updateTimeout, diags := timeoutsLocal.Update(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(updateTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -446,9 +467,9 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 
 			return subRes, subRes.State, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    updateTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
@@ -463,6 +484,8 @@ func (rs *subaccountResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.SkipAutoEntitlement = state.SkipAutoEntitlement
 	}
 
+	plan.Timeouts = timeoutsLocal
+
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, plan)
@@ -506,6 +529,10 @@ func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -531,9 +558,9 @@ func (rs *subaccountResource) Delete(ctx context.Context, req resource.DeleteReq
 
 			return subRes, subRes.State, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	stateRes, err := deleteStateConf.WaitForStateContext(ctx)
@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountCloudfoundryEnvironmentResource() resource.Resource {
+	return &subaccountCloudfoundryEnvironmentResource{}
+}
+
+type subaccountCloudfoundryEnvironmentResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_cloudfoundry_environment", req.ProviderTypeName)
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creates a Cloud Foundry environment instance, i.e. a Cloud Foundry org, in a subaccount. In contrast to the resource ` + "`btp_subaccount_environment_instance`" + ` the parameters of the org are configured as typed attributes and the API endpoint and org ID are available as attributes.
+
+__Tips:__
+* You must be assigned to the admin role of the subaccount.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/org-management-using-sap-btp-command-line-interface-btp-cli>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment instance.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Cloud Foundry plan. Possible values are `standard`, `free` and `trial`. The default value is `standard`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("standard"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"landscape_label": schema.StringAttribute{
+				MarkdownDescription: "The name of the landscape within the logged in region on which the environment instance is created. Some regions offer multiple Cloud Foundry environments, the available environments can be looked up using the data source `btp_subaccount_environments`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Cloud Foundry org.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "The memory quota of the Cloud Foundry org in MB.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the Cloud Foundry environment instance.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the Cloud Foundry environment instance.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the Cloud Foundry environment instance.",
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Cloud Foundry API endpoint, e.g. for the configuration of the Cloud Foundry provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Cloud Foundry org.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Cloud Foundry org as reported by the environment broker.",
+				Computed:            true,
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the service dashboard, which is a web-based management user interface for the service instances.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the environment instance.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type subaccountCloudfoundryEnvironmentIdentityModel struct {
+	SubaccountID types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountCloudfoundryEnvironmentType
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Cloud Foundry Environment (Subaccount)")
+		return
+	}
+
+	if cliRes.EnvironmentType != cloudfoundryEnvironmentType {
+		resp.Diagnostics.AddError("API Error Reading Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("environment instance %s is of type %q and not a Cloud Foundry environment", cliRes.Id, cliRes.EnvironmentType))
+		return
+	}
+
+	updatedState, diags := subaccountCloudfoundryEnvironmentValueFrom(cliRes)
+	resp.Diagnostics.Append(diags...)
+	updatedState.Timeouts = state.Timeouts
+
+	// When importing a resource the state is empty and all typed parameters are taken from the API.
+	// Otherwise the parameters that are not configured are reset, as the API adds defaults to the parameters.
+	if !state.Name.IsNull() {
+		resetUnconfiguredCloudfoundryEnvironmentParameters(&updatedState, state)
+	}
+
+	diags = resp.State.Set(ctx, &updatedState)
+	resp.Diagnostics.Append(diags...)
+
+	var identity subaccountCloudfoundryEnvironmentIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.Id.IsNull() {
+		identity = subaccountCloudfoundryEnvironmentIdentityModel{
+			SubaccountID: updatedState.SubaccountId,
+			Id:           updatedState.Id,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountCloudfoundryEnvironmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := cloudfoundryEnvironmentParametersFrom(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Accounts.EnvironmentInstance.Create(ctx, &btpcli.SubaccountEnvironmentInstanceCreateInput{
+		SubaccountID:    plan.SubaccountId.ValueString(),
+		DisplayName:     plan.Name.ValueString(),
+		Service:         cloudfoundryServiceName,
+		Plan:            plan.PlanName.ValueString(),
+		EnvironmentType: cloudfoundryEnvironmentType,
+		Landscape:       plan.LandscapeLabel.ValueString(),
+		Parameters:      parameters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(createTimeout)
+
+	// Even upon CREATE the status might change to "UPDATING" before it reaches the final "OK" state.
+	createStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateCreating, provisioning.StateUpdating},
+		Target:  []string{provisioning.StateOK, provisioning.StateCreationFailed, provisioning.StateUpdateFailed},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateCreating, nil
+			}
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    createTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := createStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateCreationFailed || updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateUpdateFailed {
+		resp.Diagnostics.AddError("API Error Creating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+
+	state, diags := subaccountCloudfoundryEnvironmentValueFrom(updatedRes.(provisioning.EnvironmentInstanceResponseObject))
+	resp.Diagnostics.Append(diags...)
+	resetUnconfiguredCloudfoundryEnvironmentParameters(&state, plan)
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountCloudfoundryEnvironmentIdentityModel{
+		SubaccountID: state.SubaccountId,
+		Id:           state.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subaccountCloudfoundryEnvironmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := cloudfoundryEnvironmentParametersFrom(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Accounts.EnvironmentInstance.Update(ctx, &btpcli.SubaccountEnvironmentInstanceUpdateInput{
+		EnvironmentID: plan.Id.ValueString(),
+		Parameters:    parameters,
+		Plan:          plan.PlanName.ValueString(),
+		SubaccountID:  plan.SubaccountId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(updateTimeout)
+
+	updateStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateUpdating},
+		Target:  []string{provisioning.StateOK, provisioning.StateUpdateFailed},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), plan.Id.ValueString())
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateUpdating, nil
+			}
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    updateTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateUpdateFailed {
+		resp.Diagnostics.AddError("API Error Updating Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+
+	state, diags := subaccountCloudfoundryEnvironmentValueFrom(updatedRes.(provisioning.EnvironmentInstanceResponseObject))
+	resp.Diagnostics.Append(diags...)
+	resetUnconfiguredCloudfoundryEnvironmentParameters(&state, plan)
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	// WORKAROUND for OpenTofu compatibility
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	identity := subaccountCloudfoundryEnvironmentIdentityModel{
+		SubaccountID: state.SubaccountId,
+		Id:           state.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	// END WORKAROUND
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subaccountCloudfoundryEnvironmentType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Accounts.EnvironmentInstance.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
+	deleteStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateDeleting},
+		Target:  []string{"DELETED", provisioning.StateDeletionFailed},
+		Refresh: func() (any, string, error) {
+			subRes, comRes, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+
+			if comRes.StatusCode == http.StatusNotFound {
+				return subRes, "DELETED", nil
+			}
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateDeleting, nil
+			}
+
+			if err != nil {
+				return subRes, subRes.State, err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := deleteStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateDeletionFailed {
+		resp.Diagnostics.AddError("API Error Deleting Resource Cloud Foundry Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+}
+
+func (rs *subaccountCloudfoundryEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: subaccount_id,environment_instance_id. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+		return
+	}
+
+	var identity subaccountCloudfoundryEnvironmentIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), identity.SubaccountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+}
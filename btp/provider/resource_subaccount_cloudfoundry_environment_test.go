@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+)
+
+func TestResourceSubaccountCloudfoundryEnvironment(t *testing.T) {
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountCloudfoundryEnvironment("uut", "this-is-not-a-uuid", "my-cf-org", ""),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - memory not positive", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountCloudfoundryEnvironment("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "my-cf-org", "memory = 0"),
+					ExpectError: regexp.MustCompile(`Attribute memory value must be at least 1, got: 0`),
+				},
+			},
+		})
+	})
+}
+
+func TestCloudfoundryEnvironmentParameters(t *testing.T) {
+	t.Run("parameters from typed attributes", func(t *testing.T) {
+		parameters, diags := cloudfoundryEnvironmentParametersFrom(subaccountCloudfoundryEnvironmentType{
+			InstanceName: types.StringValue("my-cf-org"),
+			Memory:       types.Int64Null(),
+		})
+
+		assert.False(t, diags.HasError())
+		assert.JSONEq(t, `{"instance_name":"my-cf-org"}`, parameters)
+	})
+
+	t.Run("typed attributes from API response", func(t *testing.T) {
+		value, diags := subaccountCloudfoundryEnvironmentValueFrom(provisioning.EnvironmentInstanceResponseObject{
+			Id:             "0fe7bbb7-5a17-4a4e-9f39-f89e7d20f1c9",
+			SubaccountGUID: "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+			PlanName:       "standard",
+			State:          provisioning.StateOK,
+			Labels:         `{"API Endpoint":"https://api.cf.eu10.hana.ondemand.com","Org Name":"my-cf-org","Org ID":"8d818824-394a-abcd-0815-7a3c8ce93e57"}`,
+			Parameters:     `{"instance_name":"my-cf-org","memory":4096,"status":"processed"}`,
+		})
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, types.StringValue("my-cf-org"), value.InstanceName)
+		assert.Equal(t, types.Int64Value(4096), value.Memory)
+		assert.Equal(t, types.StringValue("https://api.cf.eu10.hana.ondemand.com"), value.ApiEndpoint)
+		assert.Equal(t, types.StringValue("8d818824-394a-abcd-0815-7a3c8ce93e57"), value.OrgId)
+		assert.Equal(t, types.StringValue("my-cf-org"), value.OrgName)
+	})
+
+	t.Run("drift of configured parameters", func(t *testing.T) {
+		value := subaccountCloudfoundryEnvironmentType{
+			InstanceName: types.StringValue("renamed-cf-org"),
+			Memory:       types.Int64Value(8192),
+		}
+
+		resetUnconfiguredCloudfoundryEnvironmentParameters(&value, subaccountCloudfoundryEnvironmentType{
+			InstanceName: types.StringValue("my-cf-org"),
+			Memory:       types.Int64Value(4096),
+		})
+
+		assert.Equal(t, types.StringValue("renamed-cf-org"), value.InstanceName)
+		assert.Equal(t, types.Int64Value(8192), value.Memory)
+	})
+
+	t.Run("default of unconfigured memory", func(t *testing.T) {
+		value := subaccountCloudfoundryEnvironmentType{
+			InstanceName: types.StringValue("my-cf-org"),
+			Memory:       types.Int64Value(4096),
+		}
+
+		resetUnconfiguredCloudfoundryEnvironmentParameters(&value, subaccountCloudfoundryEnvironmentType{
+			InstanceName: types.StringValue("my-cf-org"),
+			Memory:       types.Int64Null(),
+		})
+
+		assert.Equal(t, types.StringValue("my-cf-org"), value.InstanceName)
+		assert.True(t, value.Memory.IsNull())
+	})
+}
+
+func hclResourceSubaccountCloudfoundryEnvironment(resourceName string, subaccountId string, instanceName string, attributes string) string {
+	return fmt.Sprintf(`
+resource "btp_subaccount_cloudfoundry_environment" "%s" {
+  subaccount_id = "%s"
+  name          = "my-cf-environment"
+  instance_name = "%s"
+  %s
+}`, resourceName, subaccountId, instanceName, attributes)
+}
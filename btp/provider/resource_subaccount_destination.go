@@ -20,6 +20,7 @@ import (
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
 	"github.com/SAP/terraform-provider-btp/internal/validation/jsonvalidator"
 	"github.com/SAP/terraform-provider-btp/internal/validation/typevalidator"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
 )
 
 const ErrUnexpectedImportIdentifier = "Unexpected Import Identifier"
@@ -136,8 +137,14 @@ __Notes:__
 				},
 			},
 			"service_instance_id": schema.StringAttribute{
-				MarkdownDescription: "The service instance that becomes part of the path used to access the destination of the subaccount.",
+				MarkdownDescription: "The ID of the service instance. If set, the destination is managed on the level of the service instance instead of the subaccount.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the destination.",
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -13,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
 )
 
 func newSubaccountDestinationCertificateResource() resource.Resource {
@@ -64,19 +69,29 @@ __Further Information:__
 				},
 			},
 			"certificate_content": schema.StringAttribute{
-				MarkdownDescription: "The content of the certificate in base64 format.",
+				MarkdownDescription: "The content of the certificate in base64 format. The content is not returned by the API, so it is taken over from the configuration after an import.",
 				Required:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// the content is unknown after an import and must not trigger a replacement
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the certificate content requires the certificate to be replaced.",
+						"Changing the certificate content requires the certificate to be replaced.",
+					),
 				},
 			},
 			"service_instance_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the service instance associated with this certificate.",
+				MarkdownDescription: "The ID of the service instance. If set, the certificate is managed on the level of the service instance instead of the subaccount.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
 			},
 			"certificate_nodes": schema.ListNestedAttribute{
 				MarkdownDescription: "List of certificate nodes containing details about the certificate and private key components.",
@@ -216,6 +231,28 @@ __Further Information:__
 	}
 }
 
+type subaccountDestinationCertificateIdentityModel struct {
+	SubaccountId      types.String `tfsdk:"subaccount_id"`
+	CertificateName   types.String `tfsdk:"certificate_name"`
+	ServiceInstanceId types.String `tfsdk:"service_instance_id"`
+}
+
+func (rs *subaccountDestinationCertificateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"certificate_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"service_instance_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
 func (rs *subaccountDestinationCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 
 	var data subaccountDestinationCertificateResourceType
@@ -226,13 +263,13 @@ func (rs *subaccountDestinationCertificateResource) Read(ctx context.Context, re
 		return
 	}
 
-	cliRes, _, err := rs.cli.Connectivity.DestinationCertificate.Get(ctx, &btpcli.DestinationCertificateGetInput{
+	cliRes, rawRes, err := rs.cli.Connectivity.DestinationCertificate.Get(ctx, &btpcli.DestinationCertificateGetInput{
 		SubaccountId:      data.SubaccountId.ValueString(),
 		ServiceInstanceId: data.ServiceInstanceId.ValueString(),
 		CertificateName:   data.CertificateName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("API Error Reading Resource Destination Certificate", fmt.Sprintf("%s", err))
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Destination Certificate")
 		return
 	}
 
@@ -249,6 +286,18 @@ func (rs *subaccountDestinationCertificateResource) Read(ctx context.Context, re
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
+	var identity subaccountDestinationCertificateIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.SubaccountId.IsNull() {
+		identity = subaccountDestinationCertificateIdentityModel{
+			SubaccountId:      state.SubaccountId,
+			CertificateName:   state.CertificateName,
+			ServiceInstanceId: state.ServiceInstanceId,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
 }
 
 func (rs *subaccountDestinationCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -287,11 +336,53 @@ func (rs *subaccountDestinationCertificateResource) Create(ctx context.Context,
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	identity := subaccountDestinationCertificateIdentityModel{
+		SubaccountId:      state.SubaccountId,
+		CertificateName:   state.CertificateName,
+		ServiceInstanceId: state.ServiceInstanceId,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (rs *subaccountDestinationCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
-	resp.Diagnostics.AddError("Resource Destination Certificate does not support updates", "Terraform will destroy and recreate the resource if any of the user configurable parameters are modified")
+	var state subaccountDestinationCertificateResourceType
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the only update that is supported is taking over the certificate content from the configuration after an import
+	if !state.CertificateContent.IsNull() {
+		resp.Diagnostics.AddError("Resource Destination Certificate does not support updates", "Terraform will destroy and recreate the resource if any of the user configurable parameters are modified")
+		return
+	}
+
+	var plan subaccountDestinationCertificateResourceType
+
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.CertificateContent = plan.CertificateContent
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountDestinationCertificateIdentityModel{
+		SubaccountId:      state.SubaccountId,
+		CertificateName:   state.CertificateName,
+		ServiceInstanceId: state.ServiceInstanceId,
+	}
+
+	// Set Identity for OpenTofu compatibility also during Update
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (rs *subaccountDestinationCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -316,6 +407,66 @@ func (rs *subaccountDestinationCertificateResource) Delete(ctx context.Context,
 	}
 }
 
+func (rs *subaccountDestinationCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+
+		idParts := strings.Split(req.ID, ",")
+
+		switch len(idParts) {
+		case 2:
+			if idParts[0] == "" || idParts[1] == "" {
+				resp.Diagnostics.AddError(
+					ErrUnexpectedImportIdentifier,
+					fmt.Sprintf("Expected import identifier with format: subaccount_id, certificate_name. Got: %q", req.ID),
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("certificate_name"), idParts[1])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_instance_id"), types.StringNull())...)
+
+			return
+
+		case 3:
+			if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+				resp.Diagnostics.AddError(
+					ErrUnexpectedImportIdentifier,
+					fmt.Sprintf("Expected import identifier with format: subaccount_id, certificate_name, service_instance_id. Got: %q", req.ID),
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("certificate_name"), idParts[1])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_instance_id"), idParts[2])...)
+
+			return
+
+		default:
+			resp.Diagnostics.AddError(
+				ErrUnexpectedImportIdentifier,
+				fmt.Sprintf(
+					"Expected one of:\n  - subaccount_id,certificate_name\n  - subaccount_id,certificate_name,service_instance_id\nGot: %q",
+					req.ID,
+				),
+			)
+			return
+		}
+	}
+
+	var identity subaccountDestinationCertificateIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), identity.SubaccountId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("certificate_name"), identity.CertificateName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_instance_id"), identity.ServiceInstanceId)...)
+}
+
 func (rs *subaccountDestinationCertificateResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
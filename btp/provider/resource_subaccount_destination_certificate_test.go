@@ -257,6 +257,26 @@ func TestResourceSubaccountDestinationCertificate(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("error path - service_instance_id not a valid UUID", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_destination_certificate" "uut" {
+  subaccount_id       = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  service_instance_id = "this-is-not-a-uuid"
+  certificate_name    = "test.pem"
+  certificate_content = "redacted"
+}`,
+					ExpectError: regexp.MustCompile(`Attribute service_instance_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
 }
 
 func hclResourceSubaccountDestinationCertificate(resourceName, subaccountId, certificateName, certificateContent string) string {
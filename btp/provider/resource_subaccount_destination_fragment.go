@@ -102,8 +102,11 @@ __Notes:__
 				},
 			},
 			"service_instance_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the service instance.",
+				MarkdownDescription: "The ID of the service instance. If set, the destination fragment is managed on the level of the service instance instead of the subaccount.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					uuidvalidator.ValidUUID(),
 				},
@@ -18,6 +18,7 @@ import (
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli"
 	"github.com/SAP/terraform-provider-btp/internal/validation/jsonvalidator"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
 )
 
 const modifierDesc = "Destination must be replaced due to name change."
@@ -102,8 +103,14 @@ __Notes:__
 				Computed:            true,
 			},
 			"service_instance_id": schema.StringAttribute{
-				MarkdownDescription: "The service instance that becomes part of the path used to access the destination of the subaccount.",
+				MarkdownDescription: "The ID of the service instance. If set, the destination is managed on the level of the service instance instead of the subaccount.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
 			},
 			"destination_configuration": schema.StringAttribute{
 				MarkdownDescription: "The configuration parameters for the destination.",
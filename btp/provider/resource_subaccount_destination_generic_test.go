@@ -405,6 +405,25 @@ func TestResourceSubaccountDestinationGeneric(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("error path - service_instance_id not a valid UUID", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount_destination_generic" "res7" {
+  subaccount_id             = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  service_instance_id       = "this-is-not-a-uuid"
+  destination_configuration = jsonencode({ Name = "res7", Type = "HTTP", URL = "https://myservice.example.com", ProxyType = "Internet", Authentication = "NoAuthentication" })
+}`,
+					ExpectError: regexp.MustCompile(`Attribute service_instance_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
 }
 func hclResourceDestinationGeneric(resourceName string, subaccountName string, destinationConfig map[string]string) string {
 
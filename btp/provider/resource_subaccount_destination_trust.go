@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/connectivity"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountDestinationTrustResource() resource.Resource {
+	return &subaccountDestinationTrustResource{}
+}
+
+type subaccountDestinationTrustResource struct {
+	cli *btpcli.ClientFacade
+}
+
+type subaccountDestinationTrustResourceIdentityModel struct {
+	SubaccountID types.String `tfsdk:"subaccount_id"`
+}
+
+func (rs *subaccountDestinationTrustResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_destination_trust", req.ProviderTypeName)
+}
+
+func (rs *subaccountDestinationTrustResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func subaccountDestinationTrustCertificateAttributes(trustType string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the %s destination trust.", trustType),
+			Computed:            true,
+		},
+		"base_url": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The base URL of the %s destination trust.", trustType),
+			Computed:            true,
+		},
+		"generated_on": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The generation timestamp of the %s destination trust.", trustType),
+			Computed:            true,
+		},
+		"expiration": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The expiration timestamp of the %s destination trust.", trustType),
+			Computed:            true,
+		},
+		"x509_public_key_base64": schema.StringAttribute{
+			MarkdownDescription: "The base64 encoded public certificate, enabling the establishment of trust at the target system for SAML flows.",
+			Computed:            true,
+		},
+	}
+}
+
+func (rs *subaccountDestinationTrustResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the signing trust of the destination service in a subaccount. The resource makes sure that an active trust exists, can generate a passive trust, and rotates the active trust i.e. replaces it by a newly generated trust.
+
+__Tip:__
+You must have the appropriate connectivity and destination permissions, such as:
+- Subaccount Administrator
+- Destination Administrator
+- Connectivity and Destination Administrator
+
+__Note:__
+Deleting the resource deletes the passive trust if ` + "`generate_passive_trust`" + ` is set. The active trust is not deleted, as the destinations of the subaccount depend on it.`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"id": schema.StringAttribute{ // required by hashicorps terraform plugin testing framework
+				DeprecationMessage:  "Use the `subaccount_id` attribute instead",
+				MarkdownDescription: "The ID of the subaccount.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"generate_passive_trust": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, a passive trust is generated if none exists. If set to `false`, an existing passive trust is deleted.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, e.g. a date, that rotates the active trust whenever it is changed: the active trust is replaced by a newly generated trust, whose public certificate must then be established at the target systems. The passive trust is not changed by a rotation, as the btp CLI offers no command to make it the active trust.",
+				Optional:            true,
+			},
+			"active_trust": schema.SingleNestedAttribute{
+				MarkdownDescription: "The active destination trust.",
+				Computed:            true,
+				Attributes:          subaccountDestinationTrustCertificateAttributes("active"),
+			},
+			"passive_trust": schema.SingleNestedAttribute{
+				MarkdownDescription: "The passive destination trust. Is `null` if no passive trust exists.",
+				Computed:            true,
+				Attributes:          subaccountDestinationTrustCertificateAttributes("passive"),
+			},
+		},
+	}
+}
+
+func (rs *subaccountDestinationTrustResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountDestinationTrustResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountDestinationTrustResourceType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activeTrust, rawRes, err := rs.cli.Connectivity.DestinationTrust.GetBySubaccount(ctx, state.SubaccountID.ValueString(), true)
+	if err != nil {
+		handleReadErrors(ctx, rawRes, activeTrust, resp, err, "Resource Destination Trust (Subaccount)")
+		return
+	}
+
+	passiveTrust, err := rs.getPassiveTrust(ctx, state.SubaccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	if state.GeneratePassiveTrust.IsNull() {
+		// after an import the configuration is derived from the existence of a passive trust
+		state.GeneratePassiveTrust = types.BoolValue(passiveTrust != nil)
+	}
+
+	resp.Diagnostics.Append(rs.setTrusts(ctx, &state, &activeTrust, passiveTrust)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var identity subaccountDestinationTrustResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.SubaccountID.IsNull() {
+		identity = subaccountDestinationTrustResourceIdentityModel{
+			SubaccountID: state.SubaccountID,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+func (rs *subaccountDestinationTrustResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountDestinationTrustResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccountID := plan.SubaccountID.ValueString()
+
+	activeTrust, rawRes, err := rs.cli.Connectivity.DestinationTrust.GetBySubaccount(ctx, subaccountID, true)
+	if rawRes.StatusCode == http.StatusNotFound {
+		// the existing active trust is taken over, a new one is only generated if none exists
+		activeTrust, _, err = rs.cli.Connectivity.DestinationTrust.GenerateBySubaccount(ctx, subaccountID, true)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	passiveTrust, err := rs.getPassiveTrust(ctx, subaccountID)
+	if err == nil && passiveTrust == nil && plan.GeneratePassiveTrust.ValueBool() {
+		passiveTrust, err = rs.generatePassiveTrust(ctx, subaccountID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(rs.setTrusts(ctx, &plan, &activeTrust, passiveTrust)...)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountDestinationTrustResourceIdentityModel{
+		SubaccountID: plan.SubaccountID,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *subaccountDestinationTrustResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state subaccountDestinationTrustResourceType
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccountID := plan.SubaccountID.ValueString()
+
+	passiveTrust, err := rs.getPassiveTrust(ctx, subaccountID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		// the CLI offers no command to promote the passive trust, so the rotation replaces the active trust by a newly generated one
+		_, _, err = rs.cli.Connectivity.DestinationTrust.GenerateBySubaccount(ctx, subaccountID, true)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Rotating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+	}
+
+	if passiveTrust == nil && plan.GeneratePassiveTrust.ValueBool() {
+		_, err = rs.generatePassiveTrust(ctx, subaccountID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+	} else if passiveTrust != nil && !plan.GeneratePassiveTrust.ValueBool() {
+		_, err = rs.cli.Connectivity.DestinationTrust.DeleteBySubaccount(ctx, subaccountID, false)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+	}
+
+	activeTrust, _, err := rs.cli.Connectivity.DestinationTrust.GetBySubaccount(ctx, subaccountID, true)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	passiveTrust, err = rs.getPassiveTrust(ctx, subaccountID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Reading Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(rs.setTrusts(ctx, &plan, &activeTrust, passiveTrust)...)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountDestinationTrustResourceIdentityModel{
+		SubaccountID: plan.SubaccountID,
+	}
+
+	// Set Identity for OpenTofu compatibility also during Update
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *subaccountDestinationTrustResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subaccountDestinationTrustResourceType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.GeneratePassiveTrust.ValueBool() {
+		return
+	}
+
+	rawRes, err := rs.cli.Connectivity.DestinationTrust.DeleteBySubaccount(ctx, state.SubaccountID.ValueString(), false)
+	if err != nil && rawRes.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("API Error Deleting Resource Destination Trust (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *subaccountDestinationTrustResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("subaccount_id"), path.Root("subaccount_id"), req, resp)
+}
+
+// getPassiveTrust returns the passive trust of the subaccount or nil if no passive trust exists
+func (rs *subaccountDestinationTrustResource) getPassiveTrust(ctx context.Context, subaccountID string) (*connectivity.DestinationTrust, error) {
+	passiveTrust, rawRes, err := rs.cli.Connectivity.DestinationTrust.GetBySubaccount(ctx, subaccountID, false)
+	if rawRes.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &passiveTrust, nil
+}
+
+func (rs *subaccountDestinationTrustResource) generatePassiveTrust(ctx context.Context, subaccountID string) (*connectivity.DestinationTrust, error) {
+	passiveTrust, _, err := rs.cli.Connectivity.DestinationTrust.GenerateBySubaccount(ctx, subaccountID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passiveTrust, nil
+}
+
+func (rs *subaccountDestinationTrustResource) setTrusts(ctx context.Context, data *subaccountDestinationTrustResourceType, activeTrust *connectivity.DestinationTrust, passiveTrust *connectivity.DestinationTrust) (diags diag.Diagnostics) {
+	var d diag.Diagnostics
+
+	data.ID = data.SubaccountID
+
+	data.ActiveTrust, d = subaccountDestinationTrustCertificateValueFrom(ctx, activeTrust)
+	diags.Append(d...)
+
+	data.PassiveTrust, d = subaccountDestinationTrustCertificateValueFrom(ctx, passiveTrust)
+	diags.Append(d...)
+
+	return
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/connectivity"
+)
+
+func TestResourceSubaccountDestinationTrust(t *testing.T) {
+	t.Run("happy path - rotation of the active trust", func(t *testing.T) {
+		fake := &destinationTrustServerForTest{active: "trust-1"}
+		srv := newCLIServerForTest(t, fake.handle)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountDestinationTrust("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", false, "2026-10"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "active_trust.name", "trust-1"),
+						resource.TestCheckNoResourceAttr("btp_subaccount_destination_trust.uut", "passive_trust.name"),
+					),
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountDestinationTrust("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", true, "2026-10"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "active_trust.name", "trust-1"),
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "passive_trust.name", "trust-2"),
+					),
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountDestinationTrust("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", true, "2026-11"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "active_trust.name", "trust-3"),
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "passive_trust.name", "trust-2"),
+					),
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountDestinationTrust("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", false, "2026-11"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_destination_trust.uut", "active_trust.name", "trust-3"),
+						resource.TestCheckNoResourceAttr("btp_subaccount_destination_trust.uut", "passive_trust.name"),
+					),
+				},
+			},
+		})
+
+		// the active trust is not deleted on destroy
+		assert.Equal(t, "trust-3", fake.active)
+		assert.Empty(t, fake.passive)
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountDestinationTrust("uut", "this-is-not-a-uuid", true, "2026-10"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+}
+
+func TestSubaccountDestinationTrustCertificateValueFrom(t *testing.T) {
+	t.Run("existing trust", func(t *testing.T) {
+		value, diags := subaccountDestinationTrustCertificateValueFrom(context.Background(), &connectivity.DestinationTrust{
+			Name:                "trust",
+			BaseURL:             "https://destination-configuration.cfapps.eu12.hana.ondemand.com",
+			Active:              true,
+			Expiration:          1792108800000,
+			GeneratedOn:         "2025-10-15",
+			X509PublicKeyBase64: "MIIC",
+		})
+
+		assert.False(t, diags.HasError())
+
+		var certificate subaccountDestinationTrustCertificateType
+		diags = value.As(context.Background(), &certificate, basetypes.ObjectAsOptions{})
+		assert.False(t, diags.HasError())
+
+		assert.Equal(t, types.StringValue("trust"), certificate.Name)
+		assert.Equal(t, types.StringValue("2026-10-16T00:00:00Z"), certificate.Expiration)
+		assert.Equal(t, types.StringValue("MIIC"), certificate.X509PublicKeyBase64)
+	})
+
+	t.Run("missing trust", func(t *testing.T) {
+		value, diags := subaccountDestinationTrustCertificateValueFrom(context.Background(), nil)
+
+		assert.False(t, diags.HasError())
+		assert.True(t, value.IsNull())
+	})
+}
+
+func hclResourceSubaccountDestinationTrust(resourceName string, subaccountId string, generatePassiveTrust bool, rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "btp_subaccount_destination_trust" "%s" {
+  subaccount_id          = "%s"
+  generate_passive_trust = %t
+  rotation_trigger       = "%s"
+}`, resourceName, subaccountId, generatePassiveTrust, rotationTrigger)
+}
+
+// destinationTrustServerForTest keeps the names of the active and passive destination trust, an empty name means that the trust does not exist
+type destinationTrustServerForTest struct {
+	mutex     sync.Mutex
+	active    string
+	passive   string
+	generated int
+}
+
+func (srv *destinationTrustServerForTest) handle(command string, action string, params map[string]any) string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if command != "connectivity/destination-trust" || params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" {
+		return ""
+	}
+
+	trust := &srv.active
+	if params["passive"] == "true" {
+		trust = &srv.passive
+	}
+
+	switch action {
+	case "get":
+		if *trust == "" {
+			return cliServerBackendNotFoundForTest
+		}
+		return fmt.Sprintf(`{"Name":"%s","active":%t}`, *trust, trust == &srv.active)
+	case "create":
+		srv.generated++
+		*trust = fmt.Sprintf("trust-%d", srv.generated+1)
+		return fmt.Sprintf(`{"Name":"%s","active":%t}`, *trust, trust == &srv.active)
+	case "delete":
+		*trust = ""
+		return "{}"
+	}
+	return ""
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -47,13 +48,16 @@ func (rs *subaccountEntitlementResource) Configure(_ context.Context, req resour
 	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
 }
 
-func (rs *subaccountEntitlementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (rs *subaccountEntitlementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Assigns the entitlement plan of a service, multitenant application, or environment, to a subaccount. Note that some environments, such as Cloud Foundry, are available by default to all global accounts and their subaccounts, and therefore are not made available as entitlements.
 
 __Tip:__
 You must be assigned to the admin role of the global account.
 
+__Note:__
+During planning, the service plan is validated against the entitlements of the global account. Unknown service plans are reported as errors, an amount that exceeds the remaining quota of the global account is reported as warning.
+
 __Further documentation:__
 <https://help.sap.com/docs/btp/sap-business-technology-platform/entitlements-and-quotas>`,
 		Attributes: map[string]schema.Attribute{
@@ -143,6 +147,14 @@ __Further documentation:__
 				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
 				Computed:            true,
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the entitlement.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the entitlement.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the entitlement.",
+			}),
 		},
 		Version: 1,
 	}
@@ -170,6 +182,60 @@ func (rs *subaccountEntitlementResource) IdentitySchema(_ context.Context, _ res
 	}
 }
 
+func (rs *subaccountEntitlementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the resource gets destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || rs.cli == nil {
+		return
+	}
+
+	var plan subaccountEntitlementType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ServiceName.IsUnknown() || plan.PlanName.IsUnknown() {
+		return
+	}
+
+	var currentAmount int64
+	if !req.State.Raw.IsNull() {
+		var state subaccountEntitlementType
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		samePlan := plan.ServiceName.Equal(state.ServiceName) && plan.PlanName.Equal(state.PlanName) && plan.PlanUniqueIdentifier.Equal(state.PlanUniqueIdentifier)
+
+		// We only validate the entitlement if it changes to avoid unnecessary API calls
+		if samePlan && plan.Amount.Equal(state.Amount) {
+			return
+		}
+
+		// In case of a replacement the existing assignment does not count for the new plan
+		if samePlan {
+			currentAmount = state.Amount.ValueInt64()
+		}
+	}
+
+	cliRes, source, err := listEntitlementsOfSubaccountParent(ctx, rs.cli, plan.SubaccountId)
+	if err != nil {
+		resp.Diagnostics.AddWarning("API Error Validating Resource Entitlement (Subaccount)", fmt.Sprintf("The entitlements of the subaccount's parent could not be fetched, the validation of the entitlement is skipped: %s", err))
+		return
+	}
+
+	// The parent of a subaccount that is created in the same apply is not known yet, so the quota it draws from is unknown as well
+	amount := plan.Amount
+	if plan.SubaccountId.IsUnknown() {
+		amount = types.Int64Unknown()
+	}
+
+	resp.Diagnostics.Append(validateEntitlementPlan(cliRes, source, plan.ServiceName, plan.PlanName, plan.PlanUniqueIdentifier, amount, currentAmount)...)
+}
+
 func (rs *subaccountEntitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state subaccountEntitlementType
 
@@ -239,6 +305,8 @@ func (rs *subaccountEntitlementResource) Read(ctx context.Context, req resource.
 	updatedState, diags := subaccountEntitlementValueFrom(ctx, entitlement.(btpcli.UnfoldedAssignment))
 	resp.Diagnostics.Append(diags...)
 
+	updatedState.Timeouts = state.Timeouts
+
 	diags = resp.State.Set(ctx, &updatedState)
 	resp.Diagnostics.Append(diags...)
 
@@ -306,6 +374,10 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 		return
 	}
 
+	timeout, diags := entitlementTimeout(ctx, plan.Timeouts, action)
+	responseDiagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(timeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -334,9 +406,9 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 
 			return *entitlement, entitlement.Assignment.EntityState, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	entitlement, err := createStateConf.WaitForStateContext(ctx)
@@ -349,6 +421,8 @@ func (rs *subaccountEntitlementResource) createOrUpdate(ctx context.Context, req
 	updatedState, diags := subaccountEntitlementValueFrom(ctx, entitlement.(btpcli.UnfoldedAssignment))
 	responseDiagnostics.Append(diags...)
 
+	updatedState.Timeouts = plan.Timeouts
+
 	diags = responseState.Set(ctx, &updatedState)
 	responseDiagnostics.Append(diags...)
 
@@ -405,6 +479,10 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
 	// The retryable HTTP client already handles transient network and HTTP errors.
 	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
 	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
@@ -434,9 +512,9 @@ func (rs *subaccountEntitlementResource) Delete(ctx context.Context, req resourc
 
 			return entitlement, cis_entitlements.StateProcessing, nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
 	}
 
 	_, err = deleteStateConf.WaitForStateContext(ctx)
@@ -491,6 +569,15 @@ func hasPlanQuota(state subaccountEntitlementType) bool {
 	return true
 }
 
+// entitlementTimeout determines the timeout of the create or update operation of an entitlement
+func entitlementTimeout(ctx context.Context, timeoutsValue timeouts.Value, action string) (time.Duration, diag.Diagnostics) {
+	if action == "Creating" {
+		return timeoutsValue.Create(ctx, tfutils.DefaultTimeout)
+	}
+
+	return timeoutsValue.Update(ctx, tfutils.DefaultTimeout)
+}
+
 func notFoundErr(err error) bool {
 	if err.Error() != "" && strings.Contains(err.Error(), "couldn't find resource") {
 		return true
@@ -498,6 +585,21 @@ func notFoundErr(err error) bool {
 	return false
 }
 
+// subaccountEntitlementTypeV0 represents the state of schema version 0 which does not contain the timeouts
+type subaccountEntitlementTypeV0 struct {
+	SubaccountId         types.String `tfsdk:"subaccount_id"`
+	Id                   types.String `tfsdk:"id"`
+	ServiceName          types.String `tfsdk:"service_name"`
+	PlanName             types.String `tfsdk:"plan_name"`
+	Category             types.String `tfsdk:"category"`
+	PlanId               types.String `tfsdk:"plan_id"`
+	Amount               types.Int64  `tfsdk:"amount"`
+	PlanUniqueIdentifier types.String `tfsdk:"plan_unique_identifier"`
+	State                types.String `tfsdk:"state"`
+	CreatedDate          types.String `tfsdk:"created_date"`
+	LastModified         types.String `tfsdk:"last_modified"`
+}
+
 func (rs *subaccountEntitlementResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 1 (Schema.Version)
@@ -567,7 +669,7 @@ func (rs *subaccountEntitlementResource) UpgradeState(ctx context.Context) map[i
 				},
 			},
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
-				var priorStateData subaccountEntitlementType
+				var priorStateData subaccountEntitlementTypeV0
 
 				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
 
@@ -586,6 +688,7 @@ func (rs *subaccountEntitlementResource) UpgradeState(ctx context.Context) map[i
 					State:                priorStateData.State,
 					CreatedDate:          priorStateData.CreatedDate,
 					LastModified:         priorStateData.LastModified,
+					Timeouts:             newNullTimeouts(),
 				}
 
 				if isTransferAmountRequired(priorStateData.Category.ValueString()) {
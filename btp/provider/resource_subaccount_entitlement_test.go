@@ -1,15 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func TestResourceSubaccountEntitlement(t *testing.T) {
@@ -388,3 +396,34 @@ func getImportStateIdForSubaccountEntitlement(resourceName string, serviceName s
 		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["subaccount_id"], serviceName, planName), nil
 	}
 }
+
+func TestEntitlementTimeout(t *testing.T) {
+	timeoutsValue := timeouts.Value{
+		Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("30m"),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			},
+		),
+	}
+
+	t.Run("create timeout", func(t *testing.T) {
+		timeout, diags := entitlementTimeout(context.Background(), timeoutsValue, "Creating")
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, 30*time.Minute, timeout)
+	})
+
+	t.Run("update falls back to default timeout", func(t *testing.T) {
+		timeout, diags := entitlementTimeout(context.Background(), timeoutsValue, "Updating")
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, tfutils.DefaultTimeout, timeout)
+	})
+}
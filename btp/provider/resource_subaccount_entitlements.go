@@ -0,0 +1,502 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis_entitlements"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountEntitlementsResource() resource.Resource {
+	return &subaccountEntitlementsResource{}
+}
+
+type subaccountEntitlementsResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountEntitlementsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_entitlements", req.ProviderTypeName)
+}
+
+func (rs *subaccountEntitlementsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountEntitlementsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages all entitlements of a subaccount. The resource is authoritative: entitlements that are assigned to the subaccount but not part of the configuration are removed. All changes are applied in one batched call.
+
+__Tip:__
+You must be assigned to the admin role of the global account.
+
+__Notes:__
+- Do not combine this resource with the resource ` + "`btp_subaccount_entitlement`" + ` for the same subaccount, as both would manage the same entitlements.
+- Entitlements that are assigned automatically, e.g. by a directory with auto-assignment, are not managed by this resource.
+- During planning, the service plans are validated against the entitlements of the global account. Entitlements that are going to be removed are reported as warning.
+- Service plans are identified by their service name and plan name. Service plans that can only be distinguished by their plan unique identifier are not supported, use the resource ` + "`btp_subaccount_entitlement`" + ` with the attribute ` + "`plan_unique_identifier`" + ` for them.
+
+__Further documentation:__
+<https://help.sap.com/docs/btp/sap-business-technology-platform/entitlements-and-quotas>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entitlements": schema.SetNestedAttribute{
+				MarkdownDescription: "The entitlements of the subaccount.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the entitled service.",
+							Required:            true,
+						},
+						"plan_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the entitled service plan.",
+							Required:            true,
+						},
+						"amount": schema.Int64Attribute{
+							MarkdownDescription: "The quota assigned to the subaccount. Required for service plans with a numeric quota, must not be set for service plans that are only enabled.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 2000000000),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for assigning the entitlements.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the entitlements.",
+				Delete:            true,
+				DeleteDescription: "Timeout for removing the entitlements.",
+			}),
+		},
+	}
+}
+
+type subaccountEntitlementsResourceIdentityModel struct {
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+}
+
+func (rs *subaccountEntitlementsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountEntitlementsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate if the resource gets destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || rs.cli == nil {
+		return
+	}
+
+	var plan subaccountEntitlementsType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Entitlements.IsUnknown() {
+		return
+	}
+
+	desired, diags := subaccountEntitlementsPlansFrom(ctx, plan.Entitlements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := map[string]subaccountEntitlementsPlanType{}
+	if !req.State.Raw.IsNull() {
+		var state subaccountEntitlementsType
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.SubaccountId.Equal(state.SubaccountId) {
+			current, diags = subaccountEntitlementsPlansFrom(ctx, state.Entitlements)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	var removed []string
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, isDesired := desired[key]; !isDesired {
+			removed = append(removed, key)
+		}
+	}
+
+	if len(removed) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("entitlements"),
+			"Entitlements Will Be Removed",
+			fmt.Sprintf("The following entitlements are assigned to the subaccount, but are not part of the configuration. They will be removed: %s", strings.Join(removed, ", ")),
+		)
+	}
+
+	// We only validate the entitlements that change to avoid unnecessary API calls
+	var changed []subaccountEntitlementsPlanType
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		if existing, isAssigned := current[key]; !isAssigned || !existing.Amount.Equal(desired[key].Amount) {
+			changed = append(changed, desired[key])
+		}
+	}
+
+	if len(changed) == 0 {
+		return
+	}
+
+	cliRes, source, err := listEntitlementsOfSubaccountParent(ctx, rs.cli, plan.SubaccountId)
+	if err != nil {
+		resp.Diagnostics.AddWarning("API Error Validating Resource Entitlements (Subaccount)", fmt.Sprintf("The entitlements of the subaccount's parent could not be fetched, the validation of the entitlements is skipped: %s", err))
+		return
+	}
+
+	for _, entitlement := range changed {
+		currentAmount := current[subaccountEntitlementsKey(entitlement.ServiceName.ValueString(), entitlement.PlanName.ValueString())].Amount.ValueInt64()
+
+		// The parent of a subaccount that is created in the same apply is not known yet, so the quota it draws from is unknown as well
+		amount := entitlement.Amount
+		if plan.SubaccountId.IsUnknown() {
+			amount = types.Int64Unknown()
+		}
+
+		// The diagnostics refer to the attributes of the single entitlement resource, so we attach them to the set.
+		// The plan unique identifier is not supported by this resource, see the notes in the resource description.
+		for _, d := range validateEntitlementPlan(cliRes, source, entitlement.ServiceName, entitlement.PlanName, types.StringNull(), amount, currentAmount) {
+			if d.Severity() == diag.SeverityError {
+				resp.Diagnostics.AddAttributeError(path.Root("entitlements"), d.Summary(), d.Detail())
+			} else {
+				resp.Diagnostics.AddAttributeWarning(path.Root("entitlements"), d.Summary(), d.Detail())
+			}
+		}
+
+		resp.Diagnostics.Append(validateSubaccountEntitlementsAmount(cliRes, entitlement)...)
+	}
+}
+
+func (rs *subaccountEntitlementsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountEntitlementsType
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := rs.readAssignments(ctx, state.SubaccountId.ValueString())
+	if err != nil {
+		if notFoundErr(err) {
+			// Treat "Not Found" as a signal to recreate resource see https://developer.hashicorp.com/terraform/plugin/framework/resources/read#recommendations
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error Reading Resource Entitlements (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	entitlements, diags := subaccountEntitlementsValueFrom(ctx, current)
+	resp.Diagnostics.Append(diags...)
+
+	state.Id = state.SubaccountId
+	state.Entitlements = entitlements
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	var identity subaccountEntitlementsResourceIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.SubaccountId.IsNull() {
+		identity = subaccountEntitlementsResourceIdentityModel{
+			SubaccountId: state.SubaccountId,
+		}
+
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
+
+func (rs *subaccountEntitlementsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountEntitlementsType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	updatedState, diags := rs.apply(ctx, plan, timeout, "Creating")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
+
+	identity := subaccountEntitlementsResourceIdentityModel{
+		SubaccountId: plan.SubaccountId,
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *subaccountEntitlementsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subaccountEntitlementsType
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	updatedState, diags := rs.apply(ctx, plan, timeout, "Updating")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
+
+	identity := subaccountEntitlementsResourceIdentityModel{
+		SubaccountId: plan.SubaccountId,
+	}
+
+	// Set Identity for OpenTofu compatibility also during Update
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (rs *subaccountEntitlementsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subaccountEntitlementsType
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	// Removing all entitlements is an update to an empty set of entitlements
+	state.Entitlements = types.SetValueMust(subaccountEntitlementsPlanObjType, nil)
+
+	_, diags = rs.apply(ctx, state, timeout, "Deleting")
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountEntitlementsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("subaccount_id"), req, resp)
+		return
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("subaccount_id"), path.Root("subaccount_id"), req, resp)
+}
+
+// readAssignments fetches the entitlements that are assigned to the subaccount
+func (rs *subaccountEntitlementsResource) readAssignments(ctx context.Context, subaccountId string) (map[string]subaccountEntitlementsAssignment, error) {
+	// In case of a directory with feature "ENTITLEMENTS" enabled we must hand over the ID when listing the entitlements
+	subaccountData, _, err := rs.cli.Accounts.Subaccount.Get(ctx, subaccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	parentId, isParentGlobalAccount, err := determineParentIdForEntitlement(rs.cli, ctx, subaccountData.ParentGUID)
+	if err != nil {
+		return nil, fmt.Errorf("determining parent features for entitlements: %w", err)
+	}
+
+	var cliRes cis_entitlements.EntitledAndAssignedServicesResponseObject
+	if isParentGlobalAccount {
+		cliRes, _, err = rs.cli.Accounts.Entitlement.ListBySubaccount(ctx, subaccountId)
+	} else {
+		cliRes, _, err = rs.cli.Accounts.Entitlement.ListBySubaccountWithDirectoryParent(ctx, subaccountId, parentId)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subaccountEntitlementsAssignmentsFrom(cliRes, subaccountId), nil
+}
+
+// apply assigns the planned entitlements to the subaccount and removes all others in one batched call. It waits
+// until all entitlements are processed and returns the resulting state.
+func (rs *subaccountEntitlementsResource) apply(ctx context.Context, plan subaccountEntitlementsType, timeout time.Duration, action string) (subaccountEntitlementsType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	summary := fmt.Sprintf("API Error %s Resource Entitlements (Subaccount)", action)
+	subaccountId := plan.SubaccountId.ValueString()
+
+	desired, planDiags := subaccountEntitlementsPlansFrom(ctx, plan.Entitlements)
+	diags.Append(planDiags...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	// Determine if the parent is a directory and if it has authorization enabled
+	subaccountData, _, err := rs.cli.Accounts.Subaccount.Get(ctx, subaccountId)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("%s", err))
+		return plan, diags
+	}
+
+	parentId, isParentGlobalAccount, err := determineParentIdForAuthorization(rs.cli, ctx, subaccountData.ParentGUID)
+	if err != nil {
+		diags.AddError("API Error determining parent features for authorization", fmt.Sprintf("%s", err))
+		return plan, diags
+	}
+
+	var directoryId string
+	if !isParentGlobalAccount {
+		directoryId = parentId
+	}
+
+	// The payload is calculated based on the current assignments to also remove entitlements that were assigned outside of Terraform
+	current, err := rs.readAssignments(ctx, subaccountId)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("%s", err))
+		return plan, diags
+	}
+
+	payload := buildSubaccountEntitlementsPayload(subaccountId, current, desired)
+	if len(payload) > 0 {
+		if _, err := rs.cli.Accounts.Entitlement.AssignToSubaccounts(ctx, directoryId, payload); err != nil {
+			diags.AddError(summary, fmt.Sprintf("%s", err))
+			return plan, diags
+		}
+	}
+
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(timeout)
+
+	// The retryable HTTP client already handles transient network and HTTP errors.
+	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
+	// Keeping this check ensures Terraform continues polling until the entitlements reach a stable state.
+	applyStateConf := &tfutils.StateChangeConf{
+		Pending: []string{cis_entitlements.StateProcessing},
+		Target:  []string{cis_entitlements.StateOK},
+		Refresh: func() (any, string, error) {
+			assignments, err := rs.readAssignments(ctx, subaccountId)
+
+			if tfutils.IsRetriableErrorForEntitlement(err) {
+				return nil, cis_entitlements.StateProcessing, nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			reached, err := subaccountEntitlementsTargetStateReached(assignments, desired)
+			if err != nil {
+				return assignments, cis_entitlements.StateProcessingFailed, err
+			}
+
+			if !reached {
+				return assignments, cis_entitlements.StateProcessing, nil
+			}
+
+			return assignments, cis_entitlements.StateOK, nil
+		},
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	assignments, err := applyStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("%s", err))
+		return plan, diags
+	}
+
+	entitlements, valueDiags := subaccountEntitlementsValueFrom(ctx, assignments.(map[string]subaccountEntitlementsAssignment))
+	diags.Append(valueDiags...)
+
+	plan.Id = plan.SubaccountId
+	plan.Entitlements = entitlements
+
+	return plan, diags
+}
+
+// validateSubaccountEntitlementsAmount checks that an amount is given exactly for the service plans with a numeric quota
+func validateSubaccountEntitlementsAmount(entitlements cis_entitlements.EntitledAndAssignedServicesResponseObject, entitlement subaccountEntitlementsPlanType) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if entitlement.Amount.IsUnknown() {
+		return diags
+	}
+
+	for _, service := range entitlements.EntitledServices {
+		if service.Name != entitlement.ServiceName.ValueString() {
+			continue
+		}
+
+		for _, servicePlan := range service.ServicePlans {
+			if servicePlan.Name != entitlement.PlanName.ValueString() {
+				continue
+			}
+
+			hasQuota := isTransferAmountRequired(servicePlan.Category)
+
+			if hasQuota && entitlement.Amount.IsNull() {
+				diags.AddAttributeError(
+					path.Root("entitlements"),
+					"Missing Amount",
+					fmt.Sprintf("The plan %q of service %q has a numeric quota. Specify the amount that is assigned to the subaccount.", entitlement.PlanName.ValueString(), entitlement.ServiceName.ValueString()),
+				)
+			}
+
+			if !hasQuota && !entitlement.Amount.IsNull() {
+				diags.AddAttributeError(
+					path.Root("entitlements"),
+					"Unexpected Amount",
+					fmt.Sprintf("The plan %q of service %q has no numeric quota (category %s) and is only enabled. Remove the amount.", entitlement.PlanName.ValueString(), entitlement.ServiceName.ValueString(), servicePlan.Category),
+				)
+			}
+
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis_entitlements"
+)
+
+func TestResourceSubaccountEntitlements(t *testing.T) {
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountEntitlements("uut", "this-is-not-a-uuid", `{ service_name = "alert-notification", plan_name = "free" }`),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - amount out of range", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountEntitlements("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", `{ service_name = "hana-cloud", plan_name = "hana", amount = 0 }`),
+					ExpectError: regexp.MustCompile(`Attribute entitlements\[.*\].amount value must be between 1 and 2000000000, got: 0`),
+				},
+			},
+		})
+	})
+}
+
+func TestSubaccountEntitlementsAssignmentsFrom(t *testing.T) {
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	cliRes := cis_entitlements.EntitledAndAssignedServicesResponseObject{
+		AssignedServices: []cis_entitlements.AssignedServiceResponseObject{
+			{
+				Name: "hana-cloud",
+				ServicePlans: []cis_entitlements.AssignedServicePlanResponseObject{
+					{
+						Name:     "hana",
+						Category: "SERVICE",
+						AssignmentInfo: []cis_entitlements.AssignedServicePlanSubaccountDto{
+							{EntityType: "SUBACCOUNT", EntityId: subaccountId, EntityState: "OK", Amount: 2},
+							{EntityType: "SUBACCOUNT", EntityId: "other-subaccount", EntityState: "OK", Amount: 5},
+						},
+					},
+				},
+			},
+			{
+				Name: "alert-notification",
+				ServicePlans: []cis_entitlements.AssignedServicePlanResponseObject{
+					{
+						Name:     "free",
+						Category: "ELASTIC_SERVICE",
+						AssignmentInfo: []cis_entitlements.AssignedServicePlanSubaccountDto{
+							{EntityType: "SUBACCOUNT", EntityId: subaccountId, EntityState: "OK", Amount: 1},
+						},
+					},
+					{
+						Name:     "standard",
+						Category: "ELASTIC_SERVICE",
+						AssignmentInfo: []cis_entitlements.AssignedServicePlanSubaccountDto{
+							{EntityType: "SUBACCOUNT", EntityId: subaccountId, EntityState: "OK", AutoAssigned: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assignments := subaccountEntitlementsAssignmentsFrom(cliRes, subaccountId)
+
+	assert.Equal(t, map[string]subaccountEntitlementsAssignment{
+		"hana-cloud:hana":         {ServiceName: "hana-cloud", PlanName: "hana", Category: "SERVICE", Amount: 2, State: "OK"},
+		"alert-notification:free": {ServiceName: "alert-notification", PlanName: "free", Category: "ELASTIC_SERVICE", State: "OK"},
+	}, assignments)
+
+	entitlements, diags := subaccountEntitlementsValueFrom(context.TODO(), assignments)
+	assert.False(t, diags.HasError())
+
+	plans, diags := subaccountEntitlementsPlansFrom(context.TODO(), entitlements)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]subaccountEntitlementsPlanType{
+		"hana-cloud:hana":         {ServiceName: types.StringValue("hana-cloud"), PlanName: types.StringValue("hana"), Amount: types.Int64Value(2)},
+		"alert-notification:free": {ServiceName: types.StringValue("alert-notification"), PlanName: types.StringValue("free"), Amount: types.Int64Null()},
+	}, plans)
+}
+
+func TestBuildSubaccountEntitlementsPayload(t *testing.T) {
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	current := map[string]subaccountEntitlementsAssignment{
+		"hana-cloud:hana":            {ServiceName: "hana-cloud", PlanName: "hana", Category: "SERVICE", Amount: 2, State: "OK"},
+		"alert-notification:free":    {ServiceName: "alert-notification", PlanName: "free", Category: "ELASTIC_SERVICE", State: "OK"},
+		"auditlog-viewer:free":       {ServiceName: "auditlog-viewer", PlanName: "free", Category: "APPLICATION", State: "OK"},
+		"destination:lite":           {ServiceName: "destination", PlanName: "lite", Category: "SERVICE", Amount: 1, State: "OK"},
+		"postgresql-db:trial":        {ServiceName: "postgresql-db", PlanName: "trial", Category: "SERVICE", Amount: 1, State: "OK"},
+		"feature-flags:lite-unknown": {ServiceName: "feature-flags", PlanName: "lite-unknown", Category: "ELASTIC_LIMITED", State: "OK"},
+	}
+
+	desired := map[string]subaccountEntitlementsPlanType{
+		"hana-cloud:hana":         {ServiceName: types.StringValue("hana-cloud"), PlanName: types.StringValue("hana"), Amount: types.Int64Value(4)},
+		"alert-notification:free": {ServiceName: types.StringValue("alert-notification"), PlanName: types.StringValue("free"), Amount: types.Int64Null()},
+		"destination:lite":        {ServiceName: types.StringValue("destination"), PlanName: types.StringValue("lite"), Amount: types.Int64Value(1)},
+		"connectivity:lite":       {ServiceName: types.StringValue("connectivity"), PlanName: types.StringValue("lite"), Amount: types.Int64Null()},
+		"xsuaa:application":       {ServiceName: types.StringValue("xsuaa"), PlanName: types.StringValue("application"), Amount: types.Int64Value(3)},
+	}
+
+	floatPtr := func(v float64) *float64 { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	assignment := func(serviceName string, planName string, info btpcli.SubaccountServicePlanAssignmentInfo) btpcli.SubaccountServicePlanAssignment {
+		info.SubaccountGUID = subaccountId
+		return btpcli.SubaccountServicePlanAssignment{
+			ServiceName:     serviceName,
+			ServicePlanName: planName,
+			AssignmentInfo:  []btpcli.SubaccountServicePlanAssignmentInfo{info},
+		}
+	}
+
+	t.Run("changes", func(t *testing.T) {
+		payload := buildSubaccountEntitlementsPayload(subaccountId, current, desired)
+
+		assert.Equal(t, []btpcli.SubaccountServicePlanAssignment{
+			assignment("connectivity", "lite", btpcli.SubaccountServicePlanAssignmentInfo{Enable: boolPtr(true)}),
+			assignment("hana-cloud", "hana", btpcli.SubaccountServicePlanAssignmentInfo{Amount: floatPtr(4)}),
+			assignment("xsuaa", "application", btpcli.SubaccountServicePlanAssignmentInfo{Amount: floatPtr(3)}),
+			assignment("auditlog-viewer", "free", btpcli.SubaccountServicePlanAssignmentInfo{Enable: boolPtr(false)}),
+			assignment("feature-flags", "lite-unknown", btpcli.SubaccountServicePlanAssignmentInfo{Enable: boolPtr(false)}),
+			assignment("postgresql-db", "trial", btpcli.SubaccountServicePlanAssignmentInfo{Amount: floatPtr(0)}),
+		}, payload)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		payload := buildSubaccountEntitlementsPayload(subaccountId, current, map[string]subaccountEntitlementsPlanType{
+			"hana-cloud:hana":            {ServiceName: types.StringValue("hana-cloud"), PlanName: types.StringValue("hana"), Amount: types.Int64Value(2)},
+			"alert-notification:free":    {ServiceName: types.StringValue("alert-notification"), PlanName: types.StringValue("free"), Amount: types.Int64Null()},
+			"auditlog-viewer:free":       {ServiceName: types.StringValue("auditlog-viewer"), PlanName: types.StringValue("free"), Amount: types.Int64Null()},
+			"destination:lite":           {ServiceName: types.StringValue("destination"), PlanName: types.StringValue("lite"), Amount: types.Int64Value(1)},
+			"postgresql-db:trial":        {ServiceName: types.StringValue("postgresql-db"), PlanName: types.StringValue("trial"), Amount: types.Int64Value(1)},
+			"feature-flags:lite-unknown": {ServiceName: types.StringValue("feature-flags"), PlanName: types.StringValue("lite-unknown"), Amount: types.Int64Null()},
+		})
+
+		assert.Empty(t, payload)
+	})
+}
+
+func TestSubaccountEntitlementsTargetStateReached(t *testing.T) {
+	desired := map[string]subaccountEntitlementsPlanType{
+		"hana-cloud:hana":         {ServiceName: types.StringValue("hana-cloud"), PlanName: types.StringValue("hana"), Amount: types.Int64Value(4)},
+		"alert-notification:free": {ServiceName: types.StringValue("alert-notification"), PlanName: types.StringValue("free"), Amount: types.Int64Null()},
+	}
+
+	tests := []struct {
+		description string
+		current     map[string]subaccountEntitlementsAssignment
+		reached     bool
+		wantErr     string
+	}{
+		{
+			description: "target reached",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana":         {Category: "SERVICE", Amount: 4, State: "OK"},
+				"alert-notification:free": {Category: "ELASTIC_SERVICE", State: "OK"},
+			},
+			reached: true,
+		},
+		{
+			description: "amount not yet updated",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana":         {Category: "SERVICE", Amount: 2, State: "OK"},
+				"alert-notification:free": {Category: "ELASTIC_SERVICE", State: "OK"},
+			},
+		},
+		{
+			description: "still processing",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana":         {Category: "SERVICE", Amount: 4, State: "PROCESSING"},
+				"alert-notification:free": {Category: "ELASTIC_SERVICE", State: "OK"},
+			},
+		},
+		{
+			description: "entitlement missing",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana": {Category: "SERVICE", Amount: 4, State: "OK"},
+			},
+		},
+		{
+			description: "entitlement not yet removed",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana":         {Category: "SERVICE", Amount: 4, State: "OK"},
+				"alert-notification:free": {Category: "ELASTIC_SERVICE", State: "OK"},
+				"destination:lite":        {Category: "SERVICE", Amount: 1, State: "OK"},
+			},
+		},
+		{
+			description: "processing failed",
+			current: map[string]subaccountEntitlementsAssignment{
+				"hana-cloud:hana":         {Category: "SERVICE", Amount: 2, State: "PROCESSING_FAILED", StateMessage: "insufficient quota"},
+				"alert-notification:free": {Category: "ELASTIC_SERVICE", State: "OK"},
+			},
+			wantErr: "the processing of the following entitlements failed: hana-cloud:hana: insufficient quota",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			reached, err := subaccountEntitlementsTargetStateReached(test.current, desired)
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.reached, reached)
+		})
+	}
+}
+
+func hclResourceSubaccountEntitlements(resourceName string, subaccountId string, entitlements ...string) string {
+	return fmt.Sprintf(`resource "btp_subaccount_entitlements" "%s" {
+    subaccount_id = "%s"
+    entitlements  = [%s]
+}`, resourceName, subaccountId, strings.Join(entitlements, ", "))
+}
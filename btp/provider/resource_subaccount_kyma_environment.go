@@ -0,0 +1,579 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+	"github.com/SAP/terraform-provider-btp/internal/validation/kymavalidator"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountKymaEnvironmentResource() resource.Resource {
+	return &subaccountKymaEnvironmentResource{}
+}
+
+type subaccountKymaEnvironmentResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountKymaEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_kyma_environment", req.ProviderTypeName)
+}
+
+func (rs *subaccountKymaEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountKymaEnvironmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Creates a Kyma environment instance in a subaccount. In contrast to the resource ` + "`btp_subaccount_environment_instance`" + ` the parameters of the Kyma runtime are configured as typed attributes.
+
+__Tips:__
+* You must be assigned to the admin role of the subaccount.
+* The Kyma runtime must first be assigned as entitlement to the subaccount.
+
+__Further documentation:__
+* Plans: <https://help.sap.com/docs/btp/sap-business-technology-platform/available-plans-in-kyma-environment>
+* Parameters: <https://help.sap.com/docs/btp/sap-business-technology-platform/provisioning-and-update-parameters-in-kyma-environment>`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment instance. The name is also used as name of the Kyma cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Kyma plan, for example `aws`, `azure`, `azure_lite`, `gcp`, `sap-converged-cloud`, `trial` or `free`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"landscape_label": schema.StringAttribute{
+				MarkdownDescription: "The name of the landscape within the logged in region on which the environment instance is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the hyperscaler in which the Kyma cluster is created, for example `eu-central-1`. If not set, the region is derived from the region of the subaccount.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				MarkdownDescription: "The machine type of the worker nodes. The available machine types depend on the plan. Machine types that are not known to the provider for the plan result in a warning.",
+				Optional:            true,
+				Validators: []validator.String{
+					kymavalidator.ValidMachineType(path.MatchRoot("plan_name")),
+				},
+			},
+			"autoscaler_min": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of worker nodes. The supported range depends on the plan. Values outside of the range known to the provider for the plan result in a warning.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					kymavalidator.ValidAutoscalerValue(path.MatchRoot("plan_name")),
+				},
+			},
+			"autoscaler_max": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of worker nodes. The supported range depends on the plan. Values outside of the range known to the provider for the plan result in a warning. The value must not be lower than `autoscaler_min`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastSumOf(path.MatchRoot("autoscaler_min")),
+					kymavalidator.ValidAutoscalerValue(path.MatchRoot("plan_name")),
+				},
+			},
+			"oidc": schema.SingleNestedAttribute{
+				MarkdownDescription: "The OpenID Connect configuration of the Kyma cluster. If not set, the default OIDC configuration of the subaccount is used.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The client ID of the OpenID Connect client.",
+						Required:            true,
+					},
+					"issuer_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the OpenID Connect issuer, for example `https://my-tenant.accounts.ondemand.com`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "value must be a valid URL starting with https://"),
+						},
+					},
+					"groups_claim": schema.StringAttribute{
+						MarkdownDescription: "The claim of the token that contains the groups of the user.",
+						Optional:            true,
+					},
+					"username_claim": schema.StringAttribute{
+						MarkdownDescription: "The claim of the token that is used as user name.",
+						Optional:            true,
+					},
+					"username_prefix": schema.StringAttribute{
+						MarkdownDescription: "The prefix that is added to the user name.",
+						Optional:            true,
+					},
+					"signing_algs": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The signing algorithms that are accepted for the tokens, for example `RS256`.",
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"administrators": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The users that are assigned as cluster administrators. If not set, the user who creates the environment instance is assigned.",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"modules": schema.ListNestedAttribute{
+				MarkdownDescription: "The Kyma modules that are installed in the cluster. If not set, the default modules are installed. An empty list disables the installation of the default modules.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the module, for example `api-gateway`.",
+							Required:            true,
+						},
+						"channel": schema.StringAttribute{
+							MarkdownDescription: "The release channel of the module. Possible values are `regular` and `fast`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("regular", "fast"),
+							},
+						},
+						"custom_resource_policy": schema.StringAttribute{
+							MarkdownDescription: "Defines whether the default custom resource of the module is created. Possible values are `CreateAndDelete` and `Ignore`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("CreateAndDelete", "Ignore"),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Timeout for creating the Kyma environment instance.",
+				Update:            true,
+				UpdateDescription: "Timeout for updating the Kyma environment instance.",
+				Delete:            true,
+				DeleteDescription: "Timeout for deleting the Kyma environment instance.",
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kubeconfig_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to download the kubeconfig of the Kyma cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_server_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the API server of the Kyma cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the service dashboard, which is a web-based management user interface for the service instances.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the environment instance.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type subaccountKymaEnvironmentIdentityModel struct {
+	SubaccountID types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (rs *subaccountKymaEnvironmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountKymaEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountKymaEnvironmentType
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Kyma Environment (Subaccount)")
+		return
+	}
+
+	if cliRes.EnvironmentType != kymaEnvironmentType {
+		resp.Diagnostics.AddError("API Error Reading Resource Kyma Environment (Subaccount)", fmt.Sprintf("environment instance %s is of type %q and not a Kyma environment", cliRes.Id, cliRes.EnvironmentType))
+		return
+	}
+
+	updatedState, diags := subaccountKymaEnvironmentValueFrom(ctx, cliRes)
+	resp.Diagnostics.Append(diags...)
+	updatedState.Timeouts = state.Timeouts
+
+	// When importing a resource the state is empty and all typed parameters are taken from the API.
+	// Otherwise the parameters that are not configured are reset, as the API adds defaults to the parameters.
+	if !state.Name.IsNull() {
+		resp.Diagnostics.Append(resetUnconfiguredKymaEnvironmentParameters(ctx, &updatedState, state)...)
+	}
+
+	diags = resp.State.Set(ctx, &updatedState)
+	resp.Diagnostics.Append(diags...)
+
+	var identity subaccountKymaEnvironmentIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() || identity.Id.IsNull() {
+		identity = subaccountKymaEnvironmentIdentityModel{
+			SubaccountID: updatedState.SubaccountId,
+			Id:           updatedState.Id,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (rs *subaccountKymaEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountKymaEnvironmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := kymaEnvironmentParametersFrom(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, _, err := rs.cli.Accounts.EnvironmentInstance.Create(ctx, &btpcli.SubaccountEnvironmentInstanceCreateInput{
+		SubaccountID:    plan.SubaccountId.ValueString(),
+		DisplayName:     plan.Name.ValueString(),
+		Service:         kymaServiceName,
+		Plan:            plan.PlanName.ValueString(),
+		EnvironmentType: kymaEnvironmentType,
+		Landscape:       plan.LandscapeLabel.ValueString(),
+		Parameters:      parameters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(createTimeout)
+
+	// Even upon CREATE the status might change to "UPDATING" before it reaches the final "OK" state.
+	createStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateCreating, provisioning.StateUpdating},
+		Target:  []string{provisioning.StateOK, provisioning.StateCreationFailed, provisioning.StateUpdateFailed},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateCreating, nil
+			}
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    createTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := createStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateCreationFailed || updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateUpdateFailed {
+		resp.Diagnostics.AddError("API Error Creating Resource Kyma Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+
+	state, diags := subaccountKymaEnvironmentValueFrom(ctx, updatedRes.(provisioning.EnvironmentInstanceResponseObject))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resetUnconfiguredKymaEnvironmentParameters(ctx, &state, plan)...)
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountKymaEnvironmentIdentityModel{
+		SubaccountID: state.SubaccountId,
+		Id:           state.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountKymaEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subaccountKymaEnvironmentType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := kymaEnvironmentParametersFrom(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Accounts.EnvironmentInstance.Update(ctx, &btpcli.SubaccountEnvironmentInstanceUpdateInput{
+		EnvironmentID: plan.Id.ValueString(),
+		Parameters:    parameters,
+		Plan:          plan.PlanName.ValueString(),
+		SubaccountID:  plan.SubaccountId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(updateTimeout)
+
+	updateStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateUpdating},
+		Target:  []string{provisioning.StateOK, provisioning.StateUpdateFailed},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, plan.SubaccountId.ValueString(), plan.Id.ValueString())
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateUpdating, nil
+			}
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    updateTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := updateStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateUpdateFailed {
+		resp.Diagnostics.AddError("API Error Updating Resource Kyma Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+
+	state, diags := subaccountKymaEnvironmentValueFrom(ctx, updatedRes.(provisioning.EnvironmentInstanceResponseObject))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resetUnconfiguredKymaEnvironmentParameters(ctx, &state, plan)...)
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	// WORKAROUND for OpenTofu compatibility
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	identity := subaccountKymaEnvironmentIdentityModel{
+		SubaccountID: state.SubaccountId,
+		Id:           state.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	// END WORKAROUND
+}
+
+func (rs *subaccountKymaEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subaccountKymaEnvironmentType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := rs.cli.Accounts.EnvironmentInstance.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, tfutils.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	delay, minTimeout := tfutils.CalculateDelayAndMinTimeOut(deleteTimeout)
+
+	deleteStateConf := &tfutils.StateChangeConf{
+		Pending: []string{provisioning.StateDeleting},
+		Target:  []string{"DELETED", provisioning.StateDeletionFailed},
+		Refresh: func() (any, string, error) {
+			subRes, comRes, err := rs.cli.Accounts.EnvironmentInstance.Get(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+
+			if comRes.StatusCode == http.StatusNotFound {
+				return subRes, "DELETED", nil
+			}
+
+			if tfutils.IsRetriableErrorForEnvInstance(err) {
+				return nil, provisioning.StateDeleting, nil
+			}
+
+			if err != nil {
+				return subRes, subRes.State, err
+			}
+
+			return subRes, subRes.State, nil
+		},
+		Timeout:    deleteTimeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	updatedRes, err := deleteStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Kyma Environment (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	// return an error if the environment instance is in a failed state to avoid inconsistent state in Terraform and to surface the error to the user
+	if updatedRes.(provisioning.EnvironmentInstanceResponseObject).State == provisioning.StateDeletionFailed {
+		resp.Diagnostics.AddError("API Error Deleting Resource Kyma Environment (Subaccount)", fmt.Sprintf("environment instance is in failed state: %s", updatedRes.(provisioning.EnvironmentInstanceResponseObject).State))
+		return
+	}
+}
+
+func (rs *subaccountKymaEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: subaccount_id,environment_instance_id. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+		return
+	}
+
+	var identity subaccountKymaEnvironmentIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), identity.SubaccountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+}
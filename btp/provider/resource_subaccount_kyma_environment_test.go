@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+)
+
+func TestResourceSubaccountKymaEnvironment(t *testing.T) {
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountKymaEnvironment("uut", "this-is-not-a-uuid", "aws", `machine_type = "m6i.large"`),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - autoscaler_max lower than autoscaler_min", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountKymaEnvironment("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "gcp", `autoscaler_min = 5`+"\n"+`autoscaler_max = 4`),
+					ExpectError: regexp.MustCompile(`Attribute autoscaler_max value must be at least sum of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid module channel", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountKymaEnvironment("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "aws", `modules = [{ name = "api-gateway", channel = "nightly" }]`),
+					ExpectError: regexp.MustCompile(`Attribute modules\[0\].channel value must be one of`),
+				},
+			},
+		})
+	})
+}
+
+func TestKymaEnvironmentParameters(t *testing.T) {
+	t.Run("parameters from typed attributes", func(t *testing.T) {
+		ctx := context.Background()
+
+		oidc, diags := types.ObjectValueFrom(ctx, subaccountKymaEnvironmentOidcObjType.AttrTypes, subaccountKymaEnvironmentOidcType{
+			ClientId:       types.StringValue("my-client"),
+			IssuerUrl:      types.StringValue("https://my-tenant.accounts.ondemand.com"),
+			GroupsClaim:    types.StringValue("groups"),
+			UsernameClaim:  types.StringNull(),
+			UsernamePrefix: types.StringNull(),
+			SigningAlgs:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("RS256")}),
+		})
+		assert.False(t, diags.HasError())
+
+		plan := subaccountKymaEnvironmentType{
+			Name:           types.StringValue("my-cluster"),
+			Region:         types.StringValue("eu-central-1"),
+			MachineType:    types.StringValue("m6i.large"),
+			AutoscalerMin:  types.Int64Value(3),
+			AutoscalerMax:  types.Int64Null(),
+			Oidc:           oidc,
+			Administrators: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("jenny.doe@test.com")}),
+			Modules:        types.ListValueMust(subaccountKymaEnvironmentModuleObjType, []attr.Value{}),
+		}
+
+		parameters, diags := kymaEnvironmentParametersFrom(ctx, plan)
+
+		assert.False(t, diags.HasError())
+		assert.JSONEq(t, `{
+			"name": "my-cluster",
+			"region": "eu-central-1",
+			"machineType": "m6i.large",
+			"autoScalerMin": 3,
+			"oidc": {"clientID": "my-client", "issuerURL": "https://my-tenant.accounts.ondemand.com", "groupsClaim": "groups", "signingAlgs": ["RS256"]},
+			"administrators": ["jenny.doe@test.com"],
+			"modules": {"list": []}
+		}`, parameters)
+	})
+
+	t.Run("typed attributes from API response", func(t *testing.T) {
+		value, diags := subaccountKymaEnvironmentValueFrom(context.Background(), provisioning.EnvironmentInstanceResponseObject{
+			Id:             "0fe7bbb7-5a17-4a4e-9f39-f89e7d20f1c9",
+			SubaccountGUID: "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f",
+			Name:           "my-cluster",
+			PlanName:       "aws",
+			State:          provisioning.StateOK,
+			Labels:         `{"APIServerURL":"https://api.c-123.kyma.ondemand.com","KubeconfigURL":"https://kyma-env-broker.cp.kyma.cloud.sap/kubeconfig/0fe7bbb7"}`,
+			Parameters:     `{"name":"my-cluster","region":"eu-central-1","autoScalerMin":3,"modules":{"default":true},"status":"processed"}`,
+		})
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, types.StringValue("eu-central-1"), value.Region)
+		assert.Equal(t, types.Int64Value(3), value.AutoscalerMin)
+		assert.True(t, value.AutoscalerMax.IsNull())
+		assert.True(t, value.MachineType.IsNull())
+		assert.True(t, value.Oidc.IsNull())
+		assert.True(t, value.Modules.IsNull())
+		assert.Equal(t, types.StringValue("https://api.c-123.kyma.ondemand.com"), value.ApiServerUrl)
+		assert.Equal(t, types.StringValue("https://kyma-env-broker.cp.kyma.cloud.sap/kubeconfig/0fe7bbb7"), value.KubeconfigUrl)
+	})
+
+	t.Run("kubeconfig URL not yet available", func(t *testing.T) {
+		value, diags := subaccountKymaEnvironmentValueFrom(context.Background(), provisioning.EnvironmentInstanceResponseObject{
+			Parameters: `{"name":"my-cluster"}`,
+		})
+
+		assert.False(t, diags.HasError())
+		assert.True(t, value.KubeconfigUrl.IsNull())
+		assert.True(t, value.ApiServerUrl.IsNull())
+	})
+
+	apiResponse := provisioning.EnvironmentInstanceResponseObject{
+		Name:       "my-cluster",
+		PlanName:   "aws",
+		Parameters: `{"name":"my-cluster","region":"eu-central-1","machineType":"m6i.xlarge","autoScalerMin":3,"autoScalerMax":20,"administrators":["jane.doe@test.com"],"oidc":{"clientID":"my-client","issuerURL":"https://my-tenant.accounts.ondemand.com","groupsClaim":"groups","usernameClaim":"sub","usernamePrefix":"-","signingAlgs":["RS256"]},"modules":{"list":[{"name":"api-gateway","channel":"fast","customResourcePolicy":"CreateAndDelete"}]}}`,
+	}
+
+	t.Run("drift of configured parameters", func(t *testing.T) {
+		ctx := context.Background()
+
+		oidc, diags := types.ObjectValueFrom(ctx, subaccountKymaEnvironmentOidcObjType.AttrTypes, subaccountKymaEnvironmentOidcType{
+			ClientId:       types.StringValue("my-client"),
+			IssuerUrl:      types.StringValue("https://other-tenant.accounts.ondemand.com"),
+			GroupsClaim:    types.StringValue("groups"),
+			UsernameClaim:  types.StringNull(),
+			UsernamePrefix: types.StringNull(),
+			SigningAlgs:    types.SetNull(types.StringType),
+		})
+		assert.False(t, diags.HasError())
+
+		modules, diags := types.ListValueFrom(ctx, subaccountKymaEnvironmentModuleObjType, []subaccountKymaEnvironmentModuleType{
+			{Name: types.StringValue("api-gateway"), Channel: types.StringValue("regular"), CustomResourcePolicy: types.StringNull()},
+		})
+		assert.False(t, diags.HasError())
+
+		configured := subaccountKymaEnvironmentType{
+			Region:         types.StringNull(),
+			MachineType:    types.StringValue("m6i.large"),
+			AutoscalerMin:  types.Int64Value(3),
+			AutoscalerMax:  types.Int64Value(10),
+			Oidc:           oidc,
+			Administrators: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("john.doe@test.com")}),
+			Modules:        modules,
+		}
+
+		value, diags := subaccountKymaEnvironmentValueFrom(ctx, apiResponse)
+		assert.False(t, diags.HasError())
+
+		diags = resetUnconfiguredKymaEnvironmentParameters(ctx, &value, configured)
+		assert.False(t, diags.HasError())
+
+		assert.True(t, value.Region.IsNull())
+		assert.Equal(t, types.StringValue("m6i.xlarge"), value.MachineType)
+		assert.Equal(t, types.Int64Value(3), value.AutoscalerMin)
+		assert.Equal(t, types.Int64Value(20), value.AutoscalerMax)
+		assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("jane.doe@test.com")}), value.Administrators)
+
+		var valueOidc subaccountKymaEnvironmentOidcType
+		assert.False(t, value.Oidc.As(ctx, &valueOidc, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, subaccountKymaEnvironmentOidcType{
+			ClientId:       types.StringValue("my-client"),
+			IssuerUrl:      types.StringValue("https://my-tenant.accounts.ondemand.com"),
+			GroupsClaim:    types.StringValue("groups"),
+			UsernameClaim:  types.StringNull(),
+			UsernamePrefix: types.StringNull(),
+			SigningAlgs:    types.SetNull(types.StringType),
+		}, valueOidc)
+
+		var valueModules []subaccountKymaEnvironmentModuleType
+		assert.False(t, value.Modules.ElementsAs(ctx, &valueModules, false).HasError())
+		assert.Equal(t, []subaccountKymaEnvironmentModuleType{
+			{Name: types.StringValue("api-gateway"), Channel: types.StringValue("fast"), CustomResourcePolicy: types.StringNull()},
+		}, valueModules)
+	})
+
+	t.Run("defaults of unconfigured parameters", func(t *testing.T) {
+		ctx := context.Background()
+
+		configured := subaccountKymaEnvironmentType{
+			Region:         types.StringNull(),
+			MachineType:    types.StringNull(),
+			AutoscalerMin:  types.Int64Null(),
+			AutoscalerMax:  types.Int64Null(),
+			Oidc:           types.ObjectNull(subaccountKymaEnvironmentOidcObjType.AttrTypes),
+			Administrators: types.SetNull(types.StringType),
+			Modules:        types.ListNull(subaccountKymaEnvironmentModuleObjType),
+		}
+
+		value, diags := subaccountKymaEnvironmentValueFrom(ctx, apiResponse)
+		assert.False(t, diags.HasError())
+
+		diags = resetUnconfiguredKymaEnvironmentParameters(ctx, &value, configured)
+		assert.False(t, diags.HasError())
+
+		assert.True(t, value.Region.IsNull())
+		assert.True(t, value.MachineType.IsNull())
+		assert.True(t, value.AutoscalerMin.IsNull())
+		assert.True(t, value.AutoscalerMax.IsNull())
+		assert.True(t, value.Oidc.IsNull())
+		assert.True(t, value.Administrators.IsNull())
+		assert.True(t, value.Modules.IsNull())
+	})
+}
+
+func hclResourceSubaccountKymaEnvironment(resourceName string, subaccountId string, planName string, attributes string) string {
+	return fmt.Sprintf(`
+resource "btp_subaccount_kyma_environment" "%s" {
+  subaccount_id = "%s"
+  name          = "my-kyma-cluster"
+  plan_name     = "%s"
+  %s
+}`, resourceName, subaccountId, planName, attributes)
+}
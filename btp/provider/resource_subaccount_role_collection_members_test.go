@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_authz"
+)
+
+func TestResourceSubaccountRoleCollectionMembers(t *testing.T) {
+	t.Run("happy path - create, update, import and delete", func(t *testing.T) {
+		// an assignment made outside of Terraform
+		fake := &roleCollectionMembersServerForTest{
+			users: []xsuaa_authz.UserReference{{Username: "jane.doe@test.com", Origin: "sap.default"}},
+		}
+
+		srv := newCLIServerForTest(t, func(command string, action string, params map[string]any) string {
+			if params["subaccount"] != "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" || params["roleCollectionName"] != "Subaccount Viewer" {
+				return ""
+			}
+			return fake.handle(command, action, params)
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(srv.Client()),
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountRoleCollectionMembers("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  groups = [{ group_name = "admins", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "id", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,Subaccount Viewer"),
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "groups.#", "1"),
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "attributes.#", "0"),
+						// the assignment made outside of Terraform is removed
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Groups", AttributeValue: "admins", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("btp_subaccount_role_collection_members.uut", map[string]knownvalue.Check{
+							"subaccount_id":        knownvalue.StringExact("6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"),
+							"role_collection_name": knownvalue.StringExact("Subaccount Viewer"),
+						}),
+					},
+				},
+				{
+					Config: hclProviderForCLIServerAt(srv.URL) + hclResourceSubaccountRoleCollectionMembers("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]
+  attributes = [{ attribute_name = "Department", attribute_value = "IT", origin = "terraformint-platform" }]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "users.#", "1"),
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "groups.#", "0"),
+						resource.TestCheckResourceAttr("btp_subaccount_role_collection_members.uut", "attributes.#", "1"),
+						fake.checkMembers(
+							[]xsuaa_authz.UserReference{{Username: "jenny.doe@test.com", Origin: "ldap"}},
+							[]xsuaa_authz.SamlAttrAssignment{{AttributeName: "Department", AttributeValue: "IT", IdentityProvider: "terraformint-platform"}},
+						),
+					),
+				},
+				{
+					ResourceName:    "btp_subaccount_role_collection_members.uut",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+			},
+		})
+
+		// all members are unassigned on destroy
+		assert.Empty(t, fake.users)
+		assert.Empty(t, fake.mappings)
+	})
+
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountRoleCollectionMembers("uut", "this-is-not-a-uuid", "Subaccount Viewer", `users = [{ user_name = "jenny.doe@test.com", origin = "ldap" }]`),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - groups assigned as attribute", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountRoleCollectionMembers("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer", `attributes = [{ attribute_name = "Groups", attribute_value = "admins", origin = "terraformint-platform" }]`),
+					ExpectError: regexp.MustCompile(`Attribute attributes\[.*\].attribute_name value must be none of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - origin missing", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountRoleCollectionMembers("uut", "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "Subaccount Viewer", `groups = [{ group_name = "admins" }]`),
+					ExpectError: regexp.MustCompile(`Inappropriate value for attribute "groups"`),
+				},
+			},
+		})
+	})
+}
+
+func TestRoleCollectionMembersFromValue(t *testing.T) {
+	users := []xsuaa_authz.UserReference{
+		{Username: "P000001", Email: "jenny.doe@test.com", Origin: "sap.default"},
+		{Username: "john.doe@test.com", Origin: "terraformint-platform"},
+	}
+
+	roleCollection := xsuaa_authz.RoleCollection{
+		SamlAttributeAssignment: []xsuaa_authz.SamlAttrAssignment{
+			{AttributeName: "Groups", AttributeValue: "admins", IdentityProvider: "terraformint-platform"},
+			{AttributeName: "Department", AttributeValue: "IT", IdentityProvider: "terraformint-platform"},
+		},
+	}
+
+	configured := roleCollectionMembers{
+		Users: []roleCollectionMemberUserType{
+			{Username: types.StringValue("jenny.doe@test.com"), Origin: types.StringValue("ldap")},
+		},
+	}
+
+	members := roleCollectionMembersFromValue(users, roleCollection, configured)
+
+	assert.ElementsMatch(t, []roleCollectionMemberUserType{
+		{Username: types.StringValue("jenny.doe@test.com"), Origin: types.StringValue("ldap")},
+		{Username: types.StringValue("john.doe@test.com"), Origin: types.StringValue("terraformint-platform")},
+	}, members.Users)
+	assert.Equal(t, []roleCollectionMemberGroupType{
+		{Groupname: types.StringValue("admins"), Origin: types.StringValue("terraformint-platform")},
+	}, members.Groups)
+	assert.Equal(t, []roleCollectionMemberAttributeType{
+		{AttributeName: types.StringValue("Department"), AttributeValue: types.StringValue("IT"), Origin: types.StringValue("terraformint-platform")},
+	}, members.Attributes)
+
+	t.Run("no members", func(t *testing.T) {
+		members := roleCollectionMembersFromValue(nil, xsuaa_authz.RoleCollection{}, roleCollectionMembers{})
+
+		assert.NotNil(t, members.Users)
+		assert.NotNil(t, members.Groups)
+		assert.NotNil(t, members.Attributes)
+		assert.Empty(t, members.Users)
+	})
+}
+
+func TestRoleCollectionMembersDifference(t *testing.T) {
+	current := roleCollectionMembers{
+		Users: []roleCollectionMemberUserType{
+			{Username: types.StringValue("jenny.doe@test.com"), Origin: types.StringValue("sap.default")},
+			{Username: types.StringValue("john.doe@test.com"), Origin: types.StringValue("ldap")},
+		},
+		Groups: []roleCollectionMemberGroupType{
+			{Groupname: types.StringValue("admins"), Origin: types.StringValue("terraformint-platform")},
+		},
+		Attributes: []roleCollectionMemberAttributeType{
+			{AttributeName: types.StringValue("Department"), AttributeValue: types.StringValue("IT"), Origin: types.StringValue("terraformint-platform")},
+		},
+	}
+
+	desired := roleCollectionMembers{
+		Users: []roleCollectionMemberUserType{
+			{Username: types.StringValue("jenny.doe@test.com"), Origin: types.StringValue("ldap")},
+		},
+		Groups: []roleCollectionMemberGroupType{
+			{Groupname: types.StringValue("admins"), Origin: types.StringValue("other-idp")},
+		},
+		Attributes: []roleCollectionMemberAttributeType{
+			{AttributeName: types.StringValue("Department"), AttributeValue: types.StringValue("IT"), Origin: types.StringValue("terraformint-platform")},
+		},
+	}
+
+	toBeRemoved := roleCollectionMembersDifference(current, desired)
+	toBeAdded := roleCollectionMembersDifference(desired, current)
+
+	assert.Equal(t, []roleCollectionMemberUserType{
+		{Username: types.StringValue("john.doe@test.com"), Origin: types.StringValue("ldap")},
+	}, toBeRemoved.Users)
+	assert.Equal(t, current.Groups, toBeRemoved.Groups)
+	assert.Empty(t, toBeRemoved.Attributes)
+
+	assert.Empty(t, toBeAdded.Users)
+	assert.Equal(t, desired.Groups, toBeAdded.Groups)
+	assert.Empty(t, toBeAdded.Attributes)
+
+	t.Run("remove all members", func(t *testing.T) {
+		toBeRemoved := roleCollectionMembersDifference(current, roleCollectionMembers{})
+
+		assert.Equal(t, current, toBeRemoved)
+	})
+}
+
+func hclResourceSubaccountRoleCollectionMembers(resourceName string, subaccountId string, roleCollectionName string, members string) string {
+	return fmt.Sprintf(`
+resource "btp_subaccount_role_collection_members" "%s" {
+  subaccount_id        = "%s"
+  role_collection_name = "%s"
+  %s
+}`, resourceName, subaccountId, roleCollectionName, members)
+}
+
+// roleCollectionMembersServerForTest keeps the members of a role collection like the CLI server does for the commands
+// to read, assign and unassign them
+type roleCollectionMembersServerForTest struct {
+	mutex    sync.Mutex
+	users    []xsuaa_authz.UserReference
+	mappings []xsuaa_authz.SamlAttrAssignment
+}
+
+func (srv *roleCollectionMembersServerForTest) handle(command string, action string, params map[string]any) string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if command != "security/role-collection" {
+		return ""
+	}
+
+	param := func(key string) string {
+		value, _ := params[key].(string)
+		return value
+	}
+
+	isUser := func(user xsuaa_authz.UserReference) bool {
+		return user.Username == param("userName") && user.Origin == param("origin")
+	}
+
+	isMapping := func(mapping xsuaa_authz.SamlAttrAssignment) bool {
+		if param("group") != "" {
+			return mapping.AttributeName == roleCollectionGroupsAttributeName && mapping.AttributeValue == param("group") && mapping.IdentityProvider == param("origin")
+		}
+		return mapping.AttributeName == param("attribute") && mapping.AttributeValue == param("attributeValue") && mapping.IdentityProvider == param("origin")
+	}
+
+	var response any
+	switch {
+	case action == "get" && param("showUserAssignments") == "true":
+		response = xsuaa_authz.UserAssignmentsPage{Count: len(srv.users), TotalPages: 1, Items: srv.users}
+	case action == "get" && param("showAttributeMappings") == "true":
+		response = xsuaa_authz.RoleCollection{Name: param("roleCollectionName"), SamlAttributeAssignment: srv.mappings}
+	case action == "assign" && param("userName") != "":
+		srv.users = append(srv.users, xsuaa_authz.UserReference{Username: param("userName"), Origin: param("origin")})
+	case action == "assign" && param("group") != "":
+		srv.mappings = append(srv.mappings, xsuaa_authz.SamlAttrAssignment{AttributeName: roleCollectionGroupsAttributeName, AttributeValue: param("group"), IdentityProvider: param("origin")})
+	case action == "assign":
+		srv.mappings = append(srv.mappings, xsuaa_authz.SamlAttrAssignment{AttributeName: param("attribute"), AttributeValue: param("attributeValue"), IdentityProvider: param("origin")})
+	case action == "unassign" && param("userName") != "":
+		srv.users = slices.DeleteFunc(srv.users, isUser)
+	case action == "unassign":
+		srv.mappings = slices.DeleteFunc(srv.mappings, isMapping)
+	default:
+		return ""
+	}
+
+	if response == nil {
+		response = xsuaa_authz.UserReference{}
+	}
+
+	body, _ := json.Marshal(response)
+	return string(body)
+}
+
+// checkMembers checks that the role collection has exactly the given members
+func (srv *roleCollectionMembersServerForTest) checkMembers(users []xsuaa_authz.UserReference, mappings []xsuaa_authz.SamlAttrAssignment) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		srv.mutex.Lock()
+		defer srv.mutex.Unlock()
+
+		if !assert.ObjectsAreEqual(users, srv.users) {
+			return fmt.Errorf("expected user assignments %v, got: %v", users, srv.users)
+		}
+
+		if !assert.ObjectsAreEqual(mappings, srv.mappings) {
+			return fmt.Errorf("expected attribute mappings %v, got: %v", mappings, srv.mappings)
+		}
+
+		return nil
+	}
+}
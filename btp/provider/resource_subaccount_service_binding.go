@@ -2,17 +2,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -26,6 +31,10 @@ import (
 	"github.com/SAP/terraform-provider-btp/internal/validation/jsonvalidator"
 )
 
+var serviceBindingRotationDurationRegexp = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+const serviceBindingPlannedRotationKey = "planned_rotation"
+
 func newSubaccountServiceBindingResource() resource.Resource {
 	return &subaccountServiceBindingResource{}
 }
@@ -36,6 +45,8 @@ type subaccountServiceBindingResource struct {
 
 func (rs *subaccountServiceBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_subaccount_service_binding", req.ProviderTypeName)
+	// A rotation replaces the binding, so the identity changes to the new binding
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (rs *subaccountServiceBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -51,7 +62,11 @@ func (rs *subaccountServiceBindingResource) Schema(_ context.Context, _ resource
 		MarkdownDescription: `Creates a service binding, i.e. generates access details to consume a service.
 
 __Tip:__
-You must be assigned to the admin or the service administrator role of the subaccount.`,
+You must be assigned to the admin or the service administrator role of the subaccount.
+
+__Notes:__
+* If ` + "`rotation`" + ` is configured, a new binding is created during an apply after the rotation frequency has passed. The previous binding is kept for the overlap period and deleted during the first apply after the overlap period has expired.
+* After a rotation, the resource identity refers to the new binding.`,
 		Attributes: map[string]schema.Attribute{
 			"subaccount_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the subaccount.",
@@ -90,6 +105,48 @@ You must be assigned to the admin or the service administrator role of the subac
 					mapplanmodifier.RequiresReplace(),
 				},
 			},
+			"rotation": schema.SingleNestedAttribute{
+				MarkdownDescription: "The settings for the rotation of the credentials of the service binding.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Shows whether the rotation of the credentials is enabled.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"frequency": schema.StringAttribute{
+						MarkdownDescription: "The time after which a new binding is created, e.g. `720h`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(serviceBindingRotationDurationRegexp, "must be a valid duration like 720h or 30m"),
+						},
+					},
+					"overlap": schema.StringAttribute{
+						MarkdownDescription: "The time for which the previous binding is kept after a rotation, e.g. `24h`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(serviceBindingRotationDurationRegexp, "must be a valid duration like 24h or 30m"),
+						},
+					},
+				},
+			},
+			"rotated_bindings": schema.ListNestedAttribute{
+				MarkdownDescription: "The previous bindings that are kept until their overlap period has expired.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the previous service binding.",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time after which the previous service binding is deleted in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the service binding.",
 				Computed:            true,
@@ -171,6 +228,16 @@ func (rs *subaccountServiceBindingResource) Read(ctx context.Context, req resour
 	}
 
 	updatedState, diags := subaccountServiceBindingValueFrom(ctx, cliRes)
+	updatedState.Rotation = state.Rotation
+
+	if !state.RotatedBindings.IsNull() {
+		updatedState.RotatedBindings = state.RotatedBindings
+	}
+
+	if strings.HasPrefix(updatedState.Name.ValueString(), state.Name.ValueString()+"-") {
+		// After a rotation the binding name carries a suffix, the configured name stays the same
+		updatedState.Name = state.Name
+	}
 
 	if updatedState.Parameters.IsNull() && !state.Parameters.IsNull() {
 		// The parameters are not returned by the API so we transfer the existing state to the read result if not existing
@@ -207,76 +274,145 @@ func (rs *subaccountServiceBindingResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	cliReq := btpcli.SubaccountServiceBindingCreateInput{
-		Subaccount:        plan.SubaccountId.ValueString(),
-		ServiceInstanceId: plan.ServiceInstanceId.ValueString(),
-		Name:              plan.Name.ValueString(),
-		Parameters:        plan.Parameters.ValueString(),
+	updatedRes, err := rs.createServiceBinding(ctx, plan, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+		return
 	}
 
-	if !plan.Labels.IsNull() {
-		var labels map[string][]string
-		plan.Labels.ElementsAs(ctx, &labels, false)
+	updatedPlan, diags := subaccountServiceBindingValueFrom(ctx, updatedRes)
+	updatedPlan.Parameters = plan.Parameters
+	updatedPlan.Rotation = plan.Rotation
+	resp.Diagnostics.Append(diags...)
 
-		cliReq.Labels = labels
+	diags = resp.State.Set(ctx, &updatedPlan)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountServiceBindingIdentityModel{
+		SubaccountID: updatedPlan.SubaccountId,
+		Id:           updatedPlan.Id,
 	}
 
-	cliRes, _, err := rs.cli.Services.Binding.Create(ctx, cliReq)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServiceBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subaccountServiceBindingType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updatedPlan, diags := subaccountServiceBindingValueFrom(ctx, cliRes)
+	var state subaccountServiceBindingType
+	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// The retryable HTTP client already handles transient network and HTTP errors.
-	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
-	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
-	createStateConf := &tfutils.StateChangeConf{
-		Pending: []string{servicemanager.StateInProgress},
-		Target:  []string{servicemanager.StateSucceeded},
-		Refresh: func() (any, string, error) {
-			subRes, _, err := rs.cli.Services.Binding.GetById(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+	// Only the rotation of the binding is supported, all other changes require a new binding
+	if !plan.SubaccountId.Equal(state.SubaccountId) || !plan.ServiceInstanceId.Equal(state.ServiceInstanceId) || !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.AddError("API Error Updating Resource Service Binding (Subaccount)", "This resource is not supposed to be updated")
+		return
+	}
 
-			if err != nil {
-				return subRes, "", err
-			}
+	// The rotation and the deletion of expired bindings were decided during the plan, the apply only executes them
+	var plannedRotation serviceBindingPlannedRotation
 
-			// No error returned even if operation failed
-			if subRes.LastOperation.State == servicemanager.StateFailed {
-				return subRes, subRes.LastOperation.State, errors.New("undefined API error during service binding creation")
-			}
+	plannedRotationJSON, diags := req.Private.GetKey(ctx, serviceBindingPlannedRotationKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			return subRes, subRes.LastOperation.State, nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+	if len(plannedRotationJSON) > 0 {
+		if err := json.Unmarshal(plannedRotationJSON, &plannedRotation); err != nil {
+			resp.Diagnostics.AddError("API Error Rotating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
 	}
 
-	updatedRes, err := createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error Creating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+	diags = resp.Private.SetKey(ctx, serviceBindingPlannedRotationKey, nil)
+	resp.Diagnostics.Append(diags...)
+
+	var rotatedBindings []subaccountServiceBindingRotatedBindingType
+	if !state.RotatedBindings.IsNull() {
+		diags = state.RotatedBindings.ElementsAs(ctx, &rotatedBindings, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	updatedPlan, diags = subaccountServiceBindingValueFrom(ctx, updatedRes.(servicemanager.ServiceBindingResponseObject))
-	updatedPlan.Parameters = plan.Parameters
+	updatedState := state
+	updatedState.Rotation = plan.Rotation
+
+	if plannedRotation.Rotate && plan.Rotation != nil {
+		now := time.Now()
+
+		overlap, err := time.ParseDuration(plan.Rotation.Overlap.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Rotating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+
+		updatedRes, err := rs.createServiceBinding(ctx, plan, rotatedServiceBindingName(plan.Name.ValueString(), now))
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Rotating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+
+		rotatedBindings = append(rotatedBindings, subaccountServiceBindingRotatedBindingType{
+			Id:        state.Id,
+			ExpiresAt: timeToValue(now.Add(overlap)),
+		})
+
+		updatedState, diags = subaccountServiceBindingValueFrom(ctx, updatedRes)
+		resp.Diagnostics.Append(diags...)
+
+		updatedState.Name = plan.Name
+		updatedState.Parameters = plan.Parameters
+		updatedState.Rotation = plan.Rotation
+	}
+
+	remainingBindings := []subaccountServiceBindingRotatedBindingType{}
+	for _, rotatedBinding := range rotatedBindings {
+		if !slices.Contains(plannedRotation.ExpiredBindings, rotatedBinding.Id.ValueString()) {
+			remainingBindings = append(remainingBindings, rotatedBinding)
+			continue
+		}
+
+		err := rs.deleteServiceBinding(ctx, state.SubaccountId.ValueString(), rotatedBinding.Id.ValueString())
+		if err != nil {
+			// The binding is kept in the state, so that the deletion is retried with the next apply
+			resp.Diagnostics.AddError("API Error Deleting Rotated Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+			remainingBindings = append(remainingBindings, rotatedBinding)
+		}
+	}
+
+	updatedState.RotatedBindings, diags = types.ListValueFrom(ctx, rotatedServiceBindingObjectType, remainingBindings)
 	resp.Diagnostics.Append(diags...)
 
-	diags = resp.State.Set(ctx, &updatedPlan)
+	diags = resp.State.Set(ctx, &updatedState)
 	resp.Diagnostics.Append(diags...)
 
 	identity := subaccountServiceBindingIdentityModel{
-		SubaccountID: updatedPlan.SubaccountId,
-		Id:           updatedPlan.Id,
+		SubaccountID: updatedState.SubaccountId,
+		Id:           updatedState.Id,
 	}
 
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (rs *subaccountServiceBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+func (rs *subaccountServiceBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Rotations only apply to existing bindings that are not destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
 	var plan subaccountServiceBindingType
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -284,10 +420,72 @@ func (rs *subaccountServiceBindingResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	resp.Diagnostics.AddError("API Error Updating Resource Service Binding (Subaccount)", "This resource is not supposed to be updated")
+	var state subaccountServiceBindingType
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var rotatedBindings []subaccountServiceBindingRotatedBindingType
+	if !state.RotatedBindings.IsNull() {
+		diags = state.RotatedBindings.ElementsAs(ctx, &rotatedBindings, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	now := time.Now()
+
+	plannedRotation := serviceBindingPlannedRotation{
+		Rotate:          serviceBindingRotationDue(plan.Rotation, state.CreatedDate, now),
+		ExpiredBindings: []string{},
+	}
+
+	for _, rotatedBinding := range rotatedBindings {
+		expired, err := rotatedServiceBindingExpired(rotatedBinding, now)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Invalid Expiry Date of Rotated Service Binding (Subaccount)", fmt.Sprintf("The rotated binding %s is kept, as its expiry date cannot be determined: %s", rotatedBinding.Id.ValueString(), err))
+			continue
+		}
+
+		if expired {
+			plannedRotation.ExpiredBindings = append(plannedRotation.ExpiredBindings, rotatedBinding.Id.ValueString())
+		}
+	}
+
+	if !plannedRotation.Rotate && len(plannedRotation.ExpiredBindings) == 0 {
+		diags = resp.Private.SetKey(ctx, serviceBindingPlannedRotationKey, nil)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if plannedRotation.Rotate {
+		// A new binding gets created, so all values of the current binding change
+		plan.Id = types.StringUnknown()
+		plan.Ready = types.BoolUnknown()
+		plan.Context = types.StringUnknown()
+		plan.BindResource = types.MapUnknown(types.StringType)
+		plan.Credentials = types.StringUnknown()
+		plan.State = types.StringUnknown()
+		plan.CreatedDate = types.StringUnknown()
+		plan.LastModified = types.StringUnknown()
+	}
+
+	plan.RotatedBindings = types.ListUnknown(rotatedServiceBindingObjectType)
+
+	diags = resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	plannedRotationJSON, err := json.Marshal(plannedRotation)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Rotating Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	diags = resp.Private.SetKey(ctx, serviceBindingPlannedRotationKey, plannedRotationJSON)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (rs *subaccountServiceBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -298,46 +496,26 @@ func (rs *subaccountServiceBindingResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, _, err := rs.cli.Services.Binding.Delete(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	err := rs.deleteServiceBinding(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("API Error Deleting Resource Service Binding (Subaccount)", fmt.Sprintf("%s", err))
 		return
 	}
 
-	// The retryable HTTP client already handles transient network and HTTP errors.
-	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
-	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
-	deleteStateConf := &tfutils.StateChangeConf{
-		Pending: []string{servicemanager.StateInProgress},
-		Target:  []string{"DELETED"},
-		Refresh: func() (any, string, error) {
-			subRes, comRes, err := rs.cli.Services.Binding.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
-
-			if comRes.StatusCode == http.StatusNotFound {
-				return subRes, "DELETED", nil
-			}
-
-			if err != nil {
-				return subRes, servicemanager.StateFailed, err
-			}
-
-			// No error returned even if operation failed
-			if subRes.LastOperation.State == servicemanager.StateFailed {
-				return subRes, subRes.LastOperation.State, errors.New("undefined API error during service binding deletion")
-			}
-
-			return subRes, subRes.LastOperation.State, nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+	var rotatedBindings []subaccountServiceBindingRotatedBindingType
+	if !state.RotatedBindings.IsNull() {
+		diags = state.RotatedBindings.ElementsAs(ctx, &rotatedBindings, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	_, err = deleteStateConf.WaitForStateContext(ctx)
-
-	if err != nil {
-		resp.Diagnostics.AddError("API Error Deleting Resource Service Instance (Subaccount)", fmt.Sprintf("%s", err))
-		return
+	for _, rotatedBinding := range rotatedBindings {
+		err = rs.deleteServiceBinding(ctx, state.SubaccountId.ValueString(), rotatedBinding.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Deleting Rotated Service Binding (Subaccount)", fmt.Sprintf("%s", err))
+		}
 	}
 }
 
@@ -369,3 +547,100 @@ func (rs *subaccountServiceBindingResource) ImportState(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), identity.SubaccountID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
 }
+
+func (rs *subaccountServiceBindingResource) createServiceBinding(ctx context.Context, plan subaccountServiceBindingType, name string) (servicemanager.ServiceBindingResponseObject, error) {
+	cliReq := btpcli.SubaccountServiceBindingCreateInput{
+		Subaccount:        plan.SubaccountId.ValueString(),
+		ServiceInstanceId: plan.ServiceInstanceId.ValueString(),
+		Name:              name,
+		Parameters:        plan.Parameters.ValueString(),
+	}
+
+	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
+		var labels map[string][]string
+		plan.Labels.ElementsAs(ctx, &labels, false)
+
+		cliReq.Labels = labels
+	}
+
+	cliRes, _, err := rs.cli.Services.Binding.Create(ctx, cliReq)
+	if err != nil {
+		return servicemanager.ServiceBindingResponseObject{}, err
+	}
+
+	// The retryable HTTP client already handles transient network and HTTP errors.
+	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
+	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
+	createStateConf := &tfutils.StateChangeConf{
+		Pending: []string{servicemanager.StateInProgress},
+		Target:  []string{servicemanager.StateSucceeded},
+		Refresh: func() (any, string, error) {
+			subRes, _, err := rs.cli.Services.Binding.GetById(ctx, plan.SubaccountId.ValueString(), cliRes.Id)
+
+			if err != nil {
+				return subRes, "", err
+			}
+
+			// No error returned even if operation failed
+			if subRes.LastOperation.State == servicemanager.StateFailed {
+				return subRes, subRes.LastOperation.State, errors.New("undefined API error during service binding creation")
+			}
+
+			return subRes, subRes.LastOperation.State, nil
+		},
+		Timeout:    tfutils.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	updatedRes, err := createStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return servicemanager.ServiceBindingResponseObject{}, err
+	}
+
+	return updatedRes.(servicemanager.ServiceBindingResponseObject), nil
+}
+
+func (rs *subaccountServiceBindingResource) deleteServiceBinding(ctx context.Context, subaccountId string, bindingId string) error {
+	_, cmdRes, err := rs.cli.Services.Binding.Delete(ctx, subaccountId, bindingId)
+	if cmdRes.StatusCode == http.StatusNotFound {
+		// The binding is already gone
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The retryable HTTP client already handles transient network and HTTP errors.
+	// However, the BTP API may still respond with "not ready" or "processing" errors after a successful request.
+	// Keeping this check ensures Terraform continues polling until the resource reaches a stable state.
+	deleteStateConf := &tfutils.StateChangeConf{
+		Pending: []string{servicemanager.StateInProgress},
+		Target:  []string{"DELETED"},
+		Refresh: func() (any, string, error) {
+			subRes, comRes, err := rs.cli.Services.Binding.GetById(ctx, subaccountId, bindingId)
+
+			if comRes.StatusCode == http.StatusNotFound {
+				return subRes, "DELETED", nil
+			}
+
+			if err != nil {
+				return subRes, servicemanager.StateFailed, err
+			}
+
+			// No error returned even if operation failed
+			if subRes.LastOperation.State == servicemanager.StateFailed {
+				return subRes, subRes.LastOperation.State, errors.New("undefined API error during service binding deletion")
+			}
+
+			return subRes, subRes.LastOperation.State, nil
+		},
+		Timeout:    tfutils.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = deleteStateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -3,13 +3,16 @@ package provider
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestResourceSubaccountServiceBinding(t *testing.T) {
@@ -115,6 +118,20 @@ func TestResourceSubaccountServiceBinding(t *testing.T) {
 		})
 	})
 
+	t.Run("error path - rotation frequency not a valid duration", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountServiceBindingWithRotation("uut", "00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", "tfint-test-alert-sb", "30 days", "24h"),
+					ExpectError: regexp.MustCompile(`must be a valid duration like 720h or 30m`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - service instance id mandatory", func(t *testing.T) {
 		t.Parallel()
 		resource.Test(t, resource.TestCase{
@@ -195,6 +212,64 @@ func hclResourceSubaccountServiceBindingWithLabelsByServiceInstanceBySubaccount(
 		}`, resourceName, subaccountName, serviceInstanceName, name)
 }
 
+func TestServiceBindingRotationDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	rotation := &subaccountServiceBindingRotationType{
+		Enabled:   types.BoolValue(true),
+		Frequency: types.StringValue("720h"),
+		Overlap:   types.StringValue("24h"),
+	}
+
+	disabledRotation := &subaccountServiceBindingRotationType{
+		Enabled:   types.BoolValue(false),
+		Frequency: types.StringValue("720h"),
+		Overlap:   types.StringValue("24h"),
+	}
+
+	assert.False(t, serviceBindingRotationDue(nil, types.StringValue("2026-01-01T00:00:00Z"), now))
+	assert.False(t, serviceBindingRotationDue(disabledRotation, types.StringValue("2026-01-01T00:00:00Z"), now))
+	assert.False(t, serviceBindingRotationDue(rotation, types.StringUnknown(), now))
+	assert.False(t, serviceBindingRotationDue(rotation, types.StringValue("2026-10-01T00:00:00Z"), now))
+	assert.True(t, serviceBindingRotationDue(rotation, types.StringValue("2026-09-18T12:00:00Z"), now))
+	assert.True(t, serviceBindingRotationDue(rotation, types.StringValue("2026-01-01T00:00:00Z"), now))
+}
+
+func TestRotatedServiceBindingExpired(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	expired, err := rotatedServiceBindingExpired(subaccountServiceBindingRotatedBindingType{ExpiresAt: types.StringValue("2026-10-19T00:00:00Z")}, now)
+	assert.NoError(t, err)
+	assert.False(t, expired)
+
+	expired, err = rotatedServiceBindingExpired(subaccountServiceBindingRotatedBindingType{ExpiresAt: types.StringValue("2026-10-18T12:00:00Z")}, now)
+	assert.NoError(t, err)
+	assert.True(t, expired)
+
+	expired, err = rotatedServiceBindingExpired(subaccountServiceBindingRotatedBindingType{ExpiresAt: types.StringValue("2026-10-01T00:00:00Z")}, now)
+	assert.NoError(t, err)
+	assert.True(t, expired)
+
+	// a binding without a valid expiry date is kept
+	expired, err = rotatedServiceBindingExpired(subaccountServiceBindingRotatedBindingType{ExpiresAt: types.StringNull()}, now)
+	assert.Error(t, err)
+	assert.False(t, expired)
+}
+
+func hclResourceSubaccountServiceBindingWithRotation(resourceName string, subaccountId string, serviceInstanceId string, name string, frequency string, overlap string) string {
+
+	return fmt.Sprintf(`
+		resource "btp_subaccount_service_binding" "%s"{
+		    subaccount_id       = "%s"
+			service_instance_id = "%s"
+			name                = "%s"
+			rotation = {
+				frequency = "%s"
+				overlap   = "%s"
+			}
+		}`, resourceName, subaccountId, serviceInstanceId, name, frequency, overlap)
+}
+
 func hclResourceSubaccountServiceBindingNoSubaccountId(resourceName string, serviceInstanceId string, name string) string {
 
 	return fmt.Sprintf(`
@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/validation/labelvalidator"
+	"github.com/SAP/terraform-provider-btp/internal/validation/uuidvalidator"
+)
+
+func newSubaccountServicePlatformResource() resource.Resource {
+	return &subaccountServicePlatformResource{}
+}
+
+var servicePlatformCredentialsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"username": types.StringType,
+		"password": types.StringType,
+	},
+}
+
+type subaccountServicePlatformResourceType struct {
+	/* INPUT */
+	SubaccountId types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	PlatformType types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+	Labels       types.Map    `tfsdk:"labels"`
+
+	/* OUTPUT */
+	Credentials  types.Object `tfsdk:"credentials"`
+	Ready        types.Bool   `tfsdk:"ready"`
+	CreatedDate  types.String `tfsdk:"created_date"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+type subaccountServicePlatformResource struct {
+	cli *btpcli.ClientFacade
+}
+
+func (rs *subaccountServicePlatformResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_subaccount_service_platform", req.ProviderTypeName)
+}
+
+func (rs *subaccountServicePlatformResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	rs.cli = req.ProviderData.(*btpcli.ClientFacade)
+}
+
+func (rs *subaccountServicePlatformResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Registers a platform for service consumption in a subaccount, e.g. a Kubernetes cluster that consumes services via the SAP BTP service operator.
+
+__Tip:__
+You must be assigned to the admin role of the subaccount.
+
+__Note:__
+The credentials of the platform are only returned by the registration. They are not available for imported platforms.`,
+		Attributes: map[string]schema.Attribute{
+			"subaccount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the platform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the platform.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the platform, e.g. `kubernetes`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the platform.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.SetType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "The set of words or phrases assigned to the platform.",
+				Optional:            true,
+				Validators: []validator.Map{
+					labelvalidator.ValidLabels(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "The credentials with which the platform connects to the Service Manager, e.g. to configure the SAP BTP service operator.",
+				Computed:            true,
+				Sensitive:           true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The username for basic authentication against the Service Manager.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password for basic authentication against the Service Manager.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether the platform is ready for consumption.",
+				Computed:            true,
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was created in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time when the resource was last modified in [RFC3339](https://www.ietf.org/rfc/rfc3339.txt) format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type subaccountServicePlatformIdentityModel struct {
+	SubaccountID types.String `tfsdk:"subaccount_id"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (rs *subaccountServicePlatformResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (rs *subaccountServicePlatformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountServicePlatformResourceType
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliRes, rawRes, err := rs.cli.Services.Platform.GetById(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		handleReadErrors(ctx, rawRes, cliRes, resp, err, "Resource Service Platform (Subaccount)")
+		return
+	}
+
+	newState, diags := subaccountServicePlatformValueFrom(ctx, cliRes.Id, cliRes.Name, cliRes.Type_, cliRes.Description, cliRes.Ready, cliRes.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	newState.SubaccountId = state.SubaccountId
+	newState.CreatedDate = timeToValue(cliRes.CreatedAt)
+	newState.LastModified = timeToValue(cliRes.UpdatedAt)
+
+	// The credentials are only returned by the registration of the platform
+	newState.Credentials = state.Credentials
+	if newState.Credentials.IsUnknown() {
+		newState.Credentials = types.ObjectNull(servicePlatformCredentialsObjectType.AttrTypes)
+	}
+
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+
+	var identity subaccountServicePlatformIdentityModel
+
+	diags = req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		identity = subaccountServicePlatformIdentityModel{
+			SubaccountID: state.SubaccountId,
+			Id:           newState.Id,
+		}
+
+		diags = resp.Identity.Set(ctx, identity)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (rs *subaccountServicePlatformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountServicePlatformResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliReq := btpcli.SubaccountServicePlatformRegisterInput{
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Type:        plan.PlatformType.ValueString(),
+		Description: plan.Description.ValueString(),
+		Labels:      servicePlatformLabelsFromPlan(ctx, plan),
+	}
+
+	cliRes, _, err := rs.cli.Services.Platform.Register(ctx, cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Creating Resource Service Platform (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	var labels map[string][]string
+	if cliRes.Labels != nil {
+		labels = *cliRes.Labels
+	}
+
+	state, diags := subaccountServicePlatformValueFrom(ctx, cliRes.Id, cliRes.Name, cliRes.Type_, cliRes.Description, cliRes.Ready, labels)
+	resp.Diagnostics.Append(diags...)
+
+	state.SubaccountId = plan.SubaccountId
+	state.CreatedDate = timeToValue(cliRes.CreatedAt)
+	state.LastModified = timeToValue(cliRes.UpdatedAt)
+
+	state.Credentials, diags = servicePlatformCredentialsValueFrom(cliRes.Credentials)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	identity := subaccountServicePlatformIdentityModel{
+		SubaccountID: state.SubaccountId,
+		Id:           state.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (rs *subaccountServicePlatformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan subaccountServicePlatformResourceType
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cliReq := btpcli.SubaccountServicePlatformUpdateInput{
+		Subaccount:  plan.SubaccountId.ValueString(),
+		Id:          state.Id.ValueString(),
+		NewName:     plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Labels:      servicePlatformLabelsFromPlan(ctx, plan),
+	}
+
+	cliRes, _, err := rs.cli.Services.Platform.Update(ctx, cliReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Updating Resource Service Platform (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+
+	var labels map[string][]string
+	if cliRes.Labels != nil {
+		labels = *cliRes.Labels
+	}
+
+	newState, diags := subaccountServicePlatformValueFrom(ctx, cliRes.Id, cliRes.Name, cliRes.Type_, cliRes.Description, cliRes.Ready, labels)
+	resp.Diagnostics.Append(diags...)
+
+	newState.SubaccountId = plan.SubaccountId
+	newState.CreatedDate = timeToValue(cliRes.CreatedAt)
+	newState.LastModified = timeToValue(cliRes.UpdatedAt)
+	newState.Credentials = state.Credentials
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+
+	// WORKAROUND for OpenTofu compatibility
+	// see https://github.com/SAP/terraform-provider-btp/issues/1383
+	identity := subaccountServicePlatformIdentityModel{
+		SubaccountID: newState.SubaccountId,
+		Id:           newState.Id,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	// END WORKAROUND
+}
+
+func (rs *subaccountServicePlatformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subaccountServicePlatformResourceType
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := rs.cli.Services.Platform.Unregister(ctx, state.SubaccountId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error Deleting Resource Service Platform (Subaccount)", fmt.Sprintf("%s", err))
+		return
+	}
+}
+
+func (rs *subaccountServicePlatformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: subaccount_id,id. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+		return
+	}
+
+	var identity subaccountServicePlatformIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount_id"), identity.SubaccountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+}
+
+func servicePlatformLabelsFromPlan(ctx context.Context, plan subaccountServicePlatformResourceType) map[string][]string {
+	var labels map[string][]string
+	plan.Labels.ElementsAs(ctx, &labels, false)
+
+	args := map[string][]string{}
+	maps.Copy(args, labels)
+
+	return args
+}
+
+func servicePlatformCredentialsValueFrom(credentials *servicemanager.Credentials) (types.Object, diag.Diagnostics) {
+	if credentials == nil || credentials.Basic == nil {
+		return types.ObjectNull(servicePlatformCredentialsObjectType.AttrTypes), nil
+	}
+
+	return types.ObjectValue(servicePlatformCredentialsObjectType.AttrTypes, map[string]attr.Value{
+		"username": types.StringValue(credentials.Basic.Username),
+		"password": types.StringValue(credentials.Basic.Password),
+	})
+}
+
+func subaccountServicePlatformValueFrom(ctx context.Context, id string, name string, platformType string, description string, ready bool, labels map[string][]string) (subaccountServicePlatformResourceType, diag.Diagnostics) {
+	platform := subaccountServicePlatformResourceType{
+		Id:           types.StringValue(id),
+		Name:         types.StringValue(name),
+		PlatformType: types.StringValue(platformType),
+		Description:  types.StringValue(description),
+		Ready:        types.BoolValue(ready),
+		Labels:       types.MapNull(types.SetType{ElemType: types.StringType}),
+	}
+
+	if len(labels) == 0 {
+		return platform, nil
+	}
+
+	var diags diag.Diagnostics
+	platform.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, labels)
+
+	return platform, diags
+}
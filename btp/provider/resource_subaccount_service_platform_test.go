@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+)
+
+func TestResourceSubaccountServicePlatform(t *testing.T) {
+	t.Run("error path - subaccount_id not a valid UUID", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      hclResourceSubaccountServicePlatform("uut", "this-is-not-a-uuid", "my-platform", "kubernetes"),
+					ExpectError: regexp.MustCompile(`Attribute subaccount_id value must be a valid UUID, got: this-is-not-a-uuid`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - type mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      `resource "btp_subaccount_service_platform" "uut" { subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f" name = "my-platform" }`,
+					ExpectError: regexp.MustCompile(`The argument "type" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+}
+
+func TestServicePlatformCredentialsValueFrom(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		credentials, diags := servicePlatformCredentialsValueFrom(nil)
+
+		assert.False(t, diags.HasError())
+		assert.True(t, credentials.IsNull())
+	})
+
+	t.Run("basic credentials", func(t *testing.T) {
+		credentials, diags := servicePlatformCredentialsValueFrom(&servicemanager.Credentials{
+			Basic: &servicemanager.CredentialsBasic{Username: "a-user", Password: "a-password"},
+		})
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, `"a-user"`, credentials.Attributes()["username"].String())
+		assert.Equal(t, `"a-password"`, credentials.Attributes()["password"].String())
+	})
+}
+
+func hclResourceSubaccountServicePlatform(resourceName string, subaccountId string, platformName string, platformType string) string {
+	return fmt.Sprintf(`resource "btp_subaccount_service_platform" "%s" {
+    subaccount_id = "%s"
+    name          = "%s"
+    type          = "%s"
+}`, resourceName, subaccountId, platformName, platformType)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 const updateSubscriptionResource = "UpdateResource"
 const updateTimeoutOnly = "UpdateTimeoutOnly"
 const invalidUpdateRequest = "InvalidUpdateRequest"
+const updateDependencies = "UpdateDependencies"
 
 func newSubaccountSubscriptionResource() resource.Resource {
 	return &subaccountSubscriptionResource{}
@@ -89,6 +91,26 @@ You must be assigned to the admin role of the subaccount.`,
 					jsonvalidator.ValidJSON(),
 				},
 			},
+			"dependency_update": schema.SingleNestedAttribute{
+				MarkdownDescription: "Triggers an update of the dependencies of the subscription. The update is executed when the subscription is created and whenever the content of this attribute changes.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"app_provider_custom_properties": schema.StringAttribute{
+						MarkdownDescription: "The custom properties of the application provider that are handed over to the dependent reuse services as a valid JSON object.",
+						Optional:            true,
+						CustomType:          jsontypes.NormalizedType{},
+						Validators: []validator.String{
+							jsonvalidator.ValidJSON(),
+						},
+					},
+					"triggers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Arbitrary key-value pairs. A change of any value triggers an update of the dependencies.",
+						Optional:            true,
+					},
+				},
+			},
+			"dependencies": subscriptionDependenciesAttribute(subscriptionDependencyLevels),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create:            true,
 				CreateDescription: "Timeout for creating the subscription.",
@@ -200,6 +222,35 @@ You must be assigned to the admin role of the subaccount.`,
 	}
 }
 
+func subscriptionDependenciesAttribute(level int) schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"app_name": schema.StringAttribute{
+			MarkdownDescription: "The unique registration name of the linked dependency application.",
+			Computed:            true,
+		},
+		"xsappname": schema.StringAttribute{
+			MarkdownDescription: "The xsappname configured in the security descriptor file used to create the XSUAA instance.",
+			Computed:            true,
+		},
+		"error": schema.StringAttribute{
+			MarkdownDescription: "The description of the error that occurred during the assignment of the dependency.",
+			Computed:            true,
+		},
+	}
+
+	if level > 1 {
+		attributes["dependencies"] = subscriptionDependenciesAttribute(level - 1)
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The reuse services used or required by the subscribed application and its services. The dependency tree is shown up to a depth of %d levels.", level),
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
 type SubaccountSubscriptionResourceIdentityModel struct {
 	SubaccountId types.String `tfsdk:"subaccount_id"`
 	AppName      types.String `tfsdk:"app_name"`
@@ -249,7 +300,11 @@ func (rs *subaccountSubscriptionResource) Read(ctx context.Context, req resource
 
 	newState, diags := subaccountSubscriptionValueFrom(ctx, cliRes)
 	newState.AppName = state.AppName
+	newState.DependencyUpdate = state.DependencyUpdate
 	newState.Timeouts = timeoutsLocal
+	resp.Diagnostics.Append(diags...)
+
+	newState.Dependencies, diags = rs.readDependencies(ctx, state.SubaccountId.ValueString(), technicalAppName, newState.State.ValueString())
 
 	if newState.Parameters.IsNull() && !state.Parameters.IsNull() {
 		// The parameters are not returned by the API so we transfer the existing state to the read result if not existing
@@ -317,9 +372,21 @@ func (rs *subaccountSubscriptionResource) Create(ctx context.Context, req resour
 	// due to a mismatch of the technical and commercial app name
 	updatedPlan.AppName = plan.AppName
 	updatedPlan.Parameters = plan.Parameters
+	updatedPlan.DependencyUpdate = plan.DependencyUpdate
 	updatedPlan.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(diags...)
 
+	if plan.DependencyUpdate != nil {
+		err = rs.updateDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, plan.DependencyUpdate)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Dependencies of Subscription (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+	}
+
+	updatedPlan.Dependencies, diags = rs.readDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, updatedPlan.State.ValueString())
+	resp.Diagnostics.Append(diags...)
+
 	diags = resp.State.Set(ctx, &updatedPlan)
 	resp.Diagnostics.Append(diags...)
 
@@ -396,16 +463,59 @@ func (rs *subaccountSubscriptionResource) Update(ctx context.Context, req resour
 
 		updatedPlan, diags := subaccountSubscriptionValueFrom(ctx, updatedRes.(saas_manager_service.EntitledApplicationsResponseObject))
 		updatedPlan.Parameters = plan.Parameters
+		updatedPlan.DependencyUpdate = plan.DependencyUpdate
 		updatedPlan.Timeouts = plan.Timeouts
 		resp.Diagnostics.Append(diags...)
 
+		technicalAppName, _, err := rs.determineAppNames(ctx, state.SubaccountId.ValueString(), state.AppName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error determining subscription app name (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+
+		if dependencyUpdateRequested(plan.DependencyUpdate, state.DependencyUpdate) {
+			err = rs.updateDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, plan.DependencyUpdate)
+			if err != nil {
+				resp.Diagnostics.AddError("API Error Updating Dependencies of Subscription (Subaccount)", fmt.Sprintf("%s", err))
+				return
+			}
+		}
+
+		updatedPlan.Dependencies, diags = rs.readDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, updatedPlan.State.ValueString())
+		resp.Diagnostics.Append(diags...)
+
+		diags = resp.State.Set(ctx, &updatedPlan)
+		resp.Diagnostics.Append(diags...)
+
+	case updateDependencies:
+		// Only the dependency update was changed, so the subscription itself stays untouched
+		technicalAppName, _, err := rs.determineAppNames(ctx, state.SubaccountId.ValueString(), state.AppName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error determining subscription app name (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+
+		err = rs.updateDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, plan.DependencyUpdate)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error Updating Dependencies of Subscription (Subaccount)", fmt.Sprintf("%s", err))
+			return
+		}
+
+		updatedPlan := state
+		updatedPlan.DependencyUpdate = plan.DependencyUpdate
+		updatedPlan.Timeouts = plan.Timeouts
+
+		updatedPlan.Dependencies, diags = rs.readDependencies(ctx, plan.SubaccountId.ValueString(), technicalAppName, updatedPlan.State.ValueString())
+		resp.Diagnostics.Append(diags...)
+
 		diags = resp.State.Set(ctx, &updatedPlan)
 		resp.Diagnostics.Append(diags...)
 
 	case updateTimeoutOnly:
-		// The update only tries to access the timeouts, so we do not need to call the API, we just set the state values back into the state and update the timeouts only
+		// The update only tries to access the timeouts or removes the dependency update, so we do not need to call the API, we just set the state values back into the state and update these values only
 		// Reason: The API implementations are not all idempotent which could lead to errors if we call the API for an UPDATe even if no fields were changed
 		updatedPlan := state
+		updatedPlan.DependencyUpdate = plan.DependencyUpdate
 		updatedPlan.Timeouts = plan.Timeouts
 		diags = resp.State.Set(ctx, &updatedPlan)
 		resp.Diagnostics.Append(diags...)
@@ -636,10 +746,61 @@ func checkForChanges(plan subaccountSubscriptionType, state subaccountSubscripti
 		return updateSubscriptionResource
 	}
 
-	if !plan.Timeouts.Equal(state.Timeouts) {
+	if dependencyUpdateRequested(plan.DependencyUpdate, state.DependencyUpdate) {
+		return updateDependencies
+	}
+
+	if !plan.Timeouts.Equal(state.Timeouts) || (plan.DependencyUpdate == nil && state.DependencyUpdate != nil) {
 		// An update of the timeouts can especially happen during import of the resource
 		return updateTimeoutOnly
 	}
 
 	return invalidUpdateRequest
 }
+
+func dependencyUpdateRequested(plan *subaccountSubscriptionDependencyUpdateType, state *subaccountSubscriptionDependencyUpdateType) bool {
+	// Removing the dependency update from the configuration does not trigger any API call
+	if plan == nil {
+		return false
+	}
+
+	if state == nil {
+		return true
+	}
+
+	return !plan.AppProviderCustomProperties.Equal(state.AppProviderCustomProperties) || !plan.Triggers.Equal(state.Triggers)
+}
+
+func (rs *subaccountSubscriptionResource) updateDependencies(ctx context.Context, subaccountId string, technicalAppName string, dependencyUpdate *subaccountSubscriptionDependencyUpdateType) error {
+	payload := saas_manager_service.UpdateApplicationDependenciesRequestPayload{}
+
+	if !dependencyUpdate.AppProviderCustomProperties.IsNull() {
+		var customProperties any
+		err := json.Unmarshal([]byte(dependencyUpdate.AppProviderCustomProperties.ValueString()), &customProperties)
+		if err != nil {
+			return err
+		}
+
+		payload.AppProviderCustomProperties = &customProperties
+	}
+
+	_, _, err := rs.cli.Accounts.Subscription.UpdateDependencies(ctx, subaccountId, technicalAppName, payload)
+	return err
+}
+
+func (rs *subaccountSubscriptionResource) readDependencies(ctx context.Context, subaccountId string, technicalAppName string, subscriptionState string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The dependencies are only available for subscribed applications
+	if subscriptionState != saas_manager_service.StateSubscribed {
+		return types.ListNull(subscriptionDependencyObjectType(subscriptionDependencyLevels)), diags
+	}
+
+	depRes, _, err := rs.cli.Accounts.Subscription.GetDependencies(ctx, subaccountId, technicalAppName)
+	if err != nil {
+		diags.AddError("API Error Reading Dependencies of Subscription (Subaccount)", fmt.Sprintf("%s", err))
+		return types.ListNull(subscriptionDependencyObjectType(subscriptionDependencyLevels)), diags
+	}
+
+	return subscriptionDependenciesValueFrom(ctx, depRes.Dependencies, subscriptionDependencyLevels)
+}
@@ -1,15 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/saas_manager_service"
 )
 
 func TestResourceSubaccountSubscription(t *testing.T) {
@@ -362,6 +368,67 @@ func TestResourceSubaccountSubscription(t *testing.T) {
 
 }
 
+func TestSubscriptionDependenciesValueFrom(t *testing.T) {
+	dependencies := []saas_manager_service.DependenciesResponseObject{
+		{
+			AppName:   "reuse-service",
+			Xsappname: "reuse-service!b123",
+			Dependencies: []saas_manager_service.DependenciesResponseObject{
+				{
+					AppName:   "nested-service",
+					Xsappname: "nested-service!b456",
+					Error_:    "assignment failed",
+					Dependencies: []saas_manager_service.DependenciesResponseObject{
+						{
+							AppName: "leaf-service",
+							Dependencies: []saas_manager_service.DependenciesResponseObject{
+								{AppName: "cut-off-service"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	value, diags := subscriptionDependenciesValueFrom(context.TODO(), dependencies, subscriptionDependencyLevels)
+
+	assert.False(t, diags.HasError())
+	assert.True(t, value.ElementType(context.TODO()).Equal(subscriptionDependencyObjectType(subscriptionDependencyLevels)))
+	assert.Len(t, value.Elements(), 1)
+
+	level1 := value.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("reuse-service"), level1["app_name"])
+	assert.Equal(t, types.StringValue("reuse-service!b123"), level1["xsappname"])
+	assert.Equal(t, types.StringValue(""), level1["error"])
+
+	level2 := level1["dependencies"].(types.List).Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("nested-service"), level2["app_name"])
+	assert.Equal(t, types.StringValue("assignment failed"), level2["error"])
+
+	level3 := level2["dependencies"].(types.List).Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("leaf-service"), level3["app_name"])
+	assert.NotContains(t, level3, "dependencies")
+}
+
+func TestDependencyUpdateRequested(t *testing.T) {
+	dependencyUpdate := &subaccountSubscriptionDependencyUpdateType{
+		AppProviderCustomProperties: jsontypes.NewNormalizedValue(`{"key":"value"}`),
+		Triggers:                    types.MapNull(types.StringType),
+	}
+
+	changedDependencyUpdate := &subaccountSubscriptionDependencyUpdateType{
+		AppProviderCustomProperties: jsontypes.NewNormalizedValue(`{"key":"other value"}`),
+		Triggers:                    types.MapNull(types.StringType),
+	}
+
+	assert.False(t, dependencyUpdateRequested(nil, nil))
+	assert.False(t, dependencyUpdateRequested(nil, dependencyUpdate))
+	assert.True(t, dependencyUpdateRequested(dependencyUpdate, nil))
+	assert.False(t, dependencyUpdateRequested(dependencyUpdate, dependencyUpdate))
+	assert.True(t, dependencyUpdateRequested(changedDependencyUpdate, dependencyUpdate))
+}
+
 func hclResourceSubaccountSubscriptionBySubaccount(resourceName string, subaccountName string, appName string, planName string) string {
 	return fmt.Sprintf(`
 		data "btp_subaccounts" "all" {}
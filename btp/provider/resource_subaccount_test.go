@@ -380,6 +380,28 @@ func TestResourceSubaccount(t *testing.T) {
 		})
 	})
 
+	t.Run("error path - invalid timeout", func(t *testing.T) {
+		t.Parallel()
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: getProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "btp_subaccount" "uut" {
+    name      = "a-subaccount"
+    region    = "eu12"
+    subdomain = "a-subaccount"
+    timeouts  = {
+        delete = "one hour"
+    }
+}`,
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - cli server returns error", func(t *testing.T) {
 		t.Parallel()
 		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -54,6 +55,46 @@ func directoryValueFrom(ctx context.Context, value cis.DirectoryResponseObject)
 	return directory, summary
 }
 
+type directoryResourceType struct {
+	ID           types.String   `tfsdk:"id"`
+	CreatedBy    types.String   `tfsdk:"created_by"`
+	CreatedDate  types.String   `tfsdk:"created_date"`
+	Description  types.String   `tfsdk:"description"`
+	Features     types.Set      `tfsdk:"features"`
+	Labels       types.Map      `tfsdk:"labels"`
+	LastModified types.String   `tfsdk:"last_modified"`
+	Name         types.String   `tfsdk:"name"`
+	ParentID     types.String   `tfsdk:"parent_id"`
+	State        types.String   `tfsdk:"state"`
+	Subdomain    types.String   `tfsdk:"subdomain"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func directoryResourceValueFrom(ctx context.Context, value cis.DirectoryResponseObject) (directoryResourceType, diag.Diagnostics) {
+	directory := directoryResourceType{
+		ID:           types.StringValue(value.Guid),
+		CreatedBy:    types.StringValue(value.CreatedBy),
+		CreatedDate:  timeToValue(value.CreatedDate.Time()),
+		Description:  types.StringValue(value.Description),
+		LastModified: timeToValue(value.ModifiedDate.Time()),
+		Name:         types.StringValue(value.DisplayName),
+		ParentID:     types.StringValue(value.ParentGUID),
+		State:        types.StringValue(value.EntityState),
+		Subdomain:    types.StringValue(value.Subdomain),
+		Timeouts:     newNullTimeouts(),
+	}
+
+	var summary, diags diag.Diagnostics
+
+	directory.Features, diags = types.SetValueFrom(ctx, types.StringType, value.DirectoryFeatures)
+	summary.Append(diags...)
+
+	directory.Labels, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, value.Labels)
+	summary.Append(diags...)
+
+	return directory, summary
+}
+
 func getAllDirectories(ctx context.Context, resp *datasource.ReadResponse, dirResponses []cis.DirectoryResponseObject) []directoryType {
 	dirs := []directoryType{}
 	return recursivelyMapDirectories(ctx, resp, dirs, dirResponses)
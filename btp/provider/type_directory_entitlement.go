@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -11,17 +12,18 @@ import (
 )
 
 type directoryEntitlementType struct {
-	DirectoryId          types.String `tfsdk:"directory_id"`
-	Id                   types.String `tfsdk:"id"`
-	ServiceName          types.String `tfsdk:"service_name"`
-	PlanName             types.String `tfsdk:"plan_name"`
-	PlanUniqueIdentifier types.String `tfsdk:"plan_unique_identifier"`
-	Amount               types.Int64  `tfsdk:"amount"`
-	AutoAssign           types.Bool   `tfsdk:"auto_assign"`
-	AutoDistributeAmount types.Int64  `tfsdk:"auto_distribute_amount"`
-	Distribute           types.Bool   `tfsdk:"distribute"`
-	Category             types.String `tfsdk:"category"`
-	PlanId               types.String `tfsdk:"plan_id"`
+	DirectoryId          types.String   `tfsdk:"directory_id"`
+	Id                   types.String   `tfsdk:"id"`
+	ServiceName          types.String   `tfsdk:"service_name"`
+	PlanName             types.String   `tfsdk:"plan_name"`
+	PlanUniqueIdentifier types.String   `tfsdk:"plan_unique_identifier"`
+	Amount               types.Int64    `tfsdk:"amount"`
+	AutoAssign           types.Bool     `tfsdk:"auto_assign"`
+	AutoDistributeAmount types.Int64    `tfsdk:"auto_distribute_amount"`
+	Distribute           types.Bool     `tfsdk:"distribute"`
+	Category             types.String   `tfsdk:"category"`
+	PlanId               types.String   `tfsdk:"plan_id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func directoryEntitlementValueFrom(ctx context.Context, value btpcli.UnfoldedEntitlement, directoryId string, distribute bool) (directoryEntitlementType, diag.Diagnostics) {
@@ -42,6 +44,7 @@ func directoryEntitlementValueFrom(ctx context.Context, value btpcli.UnfoldedEnt
 	directoryEntitlement.AutoAssign = types.BoolValue(value.Plan.AutoAssign)
 	directoryEntitlement.AutoDistributeAmount = types.Int64Value(int64(value.Plan.AutoDistributeAmount))
 	directoryEntitlement.Distribute = types.BoolValue(distribute)
+	directoryEntitlement.Timeouts = newNullTimeouts()
 
 	return directoryEntitlement, diag.Diagnostics{}
 }
@@ -57,6 +60,7 @@ func directoryEntitlementListValueFrom(service cis_entitlements.EntitledServices
 		AutoDistributeAmount: types.Int64Value(int64(servicePlan.AutoDistributeAmount)),
 		Category:             types.StringValue(servicePlan.Category),
 		PlanId:               types.StringValue(servicePlan.UniqueIdentifier),
+		Timeouts:             newNullTimeouts(),
 	}
 
 	if isTransferAmountRequired(resDm.Category.ValueString()) {
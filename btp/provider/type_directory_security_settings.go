@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_settings"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type directorySecuritySettingsType struct {
+	DirectoryId                       types.String `tfsdk:"directory_id"`
+	CustomEmailDomains                types.Set    `tfsdk:"custom_email_domains"`
+	DefaultIdentityProvider           types.String `tfsdk:"default_identity_provider"`
+	TreatUsersWithSameEmailAsSameUser types.Bool   `tfsdk:"treat_users_with_same_email_as_same_user"`
+	AccessTokenValidity               types.Int64  `tfsdk:"access_token_validity"`
+	RefreshTokenValidity              types.Int64  `tfsdk:"refresh_token_validity"`
+	IframeDomainsList                 types.List   `tfsdk:"iframe_domains_list"`
+	UseIdpUserNameInTokens            types.Bool   `tfsdk:"use_idp_user_name_in_tokens"`
+	AutoRotateSigningKey              types.Bool   `tfsdk:"auto_rotate_signing_key"`
+}
+
+func directorySecuritySettingsValueFrom(ctx context.Context, directoryId types.String, value xsuaa_settings.TenantSettingsResp) (tenantSettings directorySecuritySettingsType, diags diag.Diagnostics) {
+	tenantSettings.DirectoryId = directoryId
+	tenantSettings.TreatUsersWithSameEmailAsSameUser = types.BoolValue(value.TreatUsersWithSameEmailAsSameUser)
+
+	if len(value.DefaultIdp) > 0 {
+		tenantSettings.DefaultIdentityProvider = types.StringValue(value.DefaultIdp)
+	} else {
+		tenantSettings.DefaultIdentityProvider = types.StringNull()
+	}
+
+	if value.TokenPolicySettings != nil {
+		tenantSettings.AccessTokenValidity = types.Int64Value(int64(value.TokenPolicySettings.AccessTokenValidity))
+		tenantSettings.RefreshTokenValidity = types.Int64Value(int64(value.TokenPolicySettings.RefreshTokenValidity))
+	}
+
+	if len(value.CustomEmailDomains) > 0 {
+		tenantSettings.CustomEmailDomains, diags = types.SetValueFrom(ctx, types.StringType, value.CustomEmailDomains)
+	} else {
+		tenantSettings.CustomEmailDomains, diags = types.SetValueFrom(ctx, types.StringType, []string{})
+	}
+
+	iframeDomainsList := []string{}
+	if value.IframeDomains != "" {
+		iframeDomainsList = strings.Fields(value.IframeDomains)
+	}
+
+	tenantSettings.IframeDomainsList, _ = types.ListValueFrom(ctx, types.StringType, iframeDomainsList)
+	tenantSettings.UseIdpUserNameInTokens = types.BoolValue(value.UseIdpUserNameInTokens)
+	tenantSettings.AutoRotateSigningKey = types.BoolValue(value.RotateSigningKeyAutomatically)
+
+	return
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_trust"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type directoryTrustConfigurationListEntryType struct {
+	IdentityProvider      types.String `tfsdk:"identity_provider"`
+	Domain                types.String `tfsdk:"domain"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	LinkText              types.String `tfsdk:"link_text"`
+	AvailableForUserLogon types.Bool   `tfsdk:"available_for_user_logon"`
+	AutoCreateShadowUsers types.Bool   `tfsdk:"auto_create_shadow_users"`
+	Origin                types.String `tfsdk:"origin"`
+	Id                    types.String `tfsdk:"id"`
+	Type                  types.String `tfsdk:"type"`
+	Protocol              types.String `tfsdk:"protocol"`
+	Status                types.String `tfsdk:"status"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+}
+
+func directoryTrustConfigurationFromListEntry(ctx context.Context, value xsuaa_trust.TrustConfigurationResponseObject) (directoryTrustConfigurationListEntryType, diag.Diagnostics) {
+	availableForUserLogon, _ := strconv.ParseBool(value.AvailableForUserLogon)
+	autoCreateShadowUsers, _ := strconv.ParseBool(value.CreateShadowUsersDuringLogon)
+	return directoryTrustConfigurationListEntryType{
+		IdentityProvider:      types.StringValue(value.IdentityProvider),
+		Domain:                types.StringValue(value.Domain),
+		Name:                  types.StringValue(value.Name),
+		Description:           types.StringValue(value.Description),
+		LinkText:              types.StringValue(value.LinkTextForUserLogon),
+		AvailableForUserLogon: types.BoolValue(availableForUserLogon),
+		AutoCreateShadowUsers: types.BoolValue(autoCreateShadowUsers),
+		Origin:                types.StringValue(value.OriginKey),
+		Id:                    types.StringValue(value.OriginKey),
+		Type:                  types.StringValue(value.TypeOfTrust),
+		Protocol:              types.StringValue(value.Protocol),
+		Status:                types.StringValue(value.Status),
+		ReadOnly:              types.BoolValue(value.ReadOnly),
+	}, diag.Diagnostics{}
+}
+
+type directoryTrustConfigurationType struct {
+	DirectoryId           types.String `tfsdk:"directory_id"`
+	IdentityProvider      types.String `tfsdk:"identity_provider"`
+	Domain                types.String `tfsdk:"domain"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	LinkText              types.String `tfsdk:"link_text"`
+	AvailableForUserLogon types.Bool   `tfsdk:"available_for_user_logon"`
+	AutoCreateShadowUsers types.Bool   `tfsdk:"auto_create_shadow_users"`
+	Origin                types.String `tfsdk:"origin"`
+	Id                    types.String `tfsdk:"id"`
+	Type                  types.String `tfsdk:"type"`
+	Protocol              types.String `tfsdk:"protocol"`
+	Status                types.String `tfsdk:"status"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+}
+
+func directoryTrustConfigurationFromValue(ctx context.Context, directoryId string, value xsuaa_trust.TrustConfigurationResponseObject) (directoryTrustConfigurationType, diag.Diagnostics) {
+	availableForUserLogon, _ := strconv.ParseBool(value.AvailableForUserLogon)
+	autoCreateShadowUsers, _ := strconv.ParseBool(value.CreateShadowUsersDuringLogon)
+	return directoryTrustConfigurationType{
+		DirectoryId:           types.StringValue(directoryId),
+		IdentityProvider:      types.StringValue(value.IdentityProvider),
+		Domain:                types.StringValue(value.Domain),
+		Name:                  types.StringValue(value.Name),
+		Description:           types.StringValue(value.Description),
+		LinkText:              types.StringValue(value.LinkTextForUserLogon),
+		AvailableForUserLogon: types.BoolValue(availableForUserLogon),
+		AutoCreateShadowUsers: types.BoolValue(autoCreateShadowUsers),
+		Origin:                types.StringValue(value.OriginKey),
+		Id:                    types.StringValue(value.OriginKey),
+		Type:                  types.StringValue(value.TypeOfTrust),
+		Protocol:              types.StringValue(value.Protocol),
+		Status:                types.StringValue(value.Status),
+		ReadOnly:              types.BoolValue(value.ReadOnly),
+	}, diag.Diagnostics{}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -11,12 +12,13 @@ import (
 )
 
 type DisasterRecoverySubaccountPairType struct {
-	SubaccountId       types.String `tfsdk:"subaccount_id"`
-	PairedSubaccountId types.String `tfsdk:"paired_subaccount_id"`
-	PairId             types.String `tfsdk:"pair_id"`
-	CreatedDate        types.String `tfsdk:"created_date"`
-	CreatedBy          types.String `tfsdk:"created_by"`
-	GlobalAccountId    types.String `tfsdk:"globalaccount_id"`
+	SubaccountId       types.String   `tfsdk:"subaccount_id"`
+	PairedSubaccountId types.String   `tfsdk:"paired_subaccount_id"`
+	PairId             types.String   `tfsdk:"pair_id"`
+	CreatedDate        types.String   `tfsdk:"created_date"`
+	CreatedBy          types.String   `tfsdk:"created_by"`
+	GlobalAccountId    types.String   `tfsdk:"globalaccount_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func disasterRecoverySubaccountPairValueFrom(ctx context.Context, subaccountId types.String, pairedSubaccountId types.String, value cdr.GetSubaccountPairResponse) (DisasterRecoverySubaccountPairType, diag.Diagnostics) {
@@ -28,6 +30,7 @@ func disasterRecoverySubaccountPairValueFrom(ctx context.Context, subaccountId t
 		CreatedDate:        types.StringValue(time.Unix(value.CreatedAt, 0).Format(time.RFC3339)),
 		CreatedBy:          types.StringValue(value.CreatedBy),
 		GlobalAccountId:    types.StringValue(value.GlobalAccountId),
+		Timeouts:           newNullTimeouts(),
 	}, nil
 
 }
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const apiCredentialPrivateDataKey = "api_credential"
+
+// apiCredentialPrivateData is handed over from Open to Close of the ephemeral API credential
+// resources to identify the credential that must be deleted.
+type apiCredentialPrivateData struct {
+	Name       string `json:"name"`
+	Directory  string `json:"directory,omitempty"`
+	Subaccount string `json:"subaccount,omitempty"`
+}
+
+type privateDataSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateDataGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func setApiCredentialPrivateData(ctx context.Context, private privateDataSetter, data apiCredentialPrivateData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Unable to Store API Credential Reference", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, apiCredentialPrivateDataKey, value)
+}
+
+func getApiCredentialPrivateData(ctx context.Context, private privateDataGetter) (*apiCredentialPrivateData, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, apiCredentialPrivateDataKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var data apiCredentialPrivateData
+	if err := json.Unmarshal(value, &data); err != nil {
+		diags.AddError("Unable to Read API Credential Reference", err.Error())
+		return nil, diags
+	}
+
+	return &data, diags
+}
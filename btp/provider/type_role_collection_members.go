@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/xsuaa_authz"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
+)
+
+// roleCollectionGroupsAttributeName is the attribute name under which group assignments are returned by the API
+const roleCollectionGroupsAttributeName = "Groups"
+
+type roleCollectionMemberUserType struct {
+	Username types.String `tfsdk:"user_name"`
+	Origin   types.String `tfsdk:"origin"`
+}
+
+type roleCollectionMemberGroupType struct {
+	Groupname types.String `tfsdk:"group_name"`
+	Origin    types.String `tfsdk:"origin"`
+}
+
+type roleCollectionMemberAttributeType struct {
+	AttributeName  types.String `tfsdk:"attribute_name"`
+	AttributeValue types.String `tfsdk:"attribute_value"`
+	Origin         types.String `tfsdk:"origin"`
+}
+
+var roleCollectionMemberUserObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_name": types.StringType,
+		"origin":    types.StringType,
+	},
+}
+
+var roleCollectionMemberGroupObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"group_name": types.StringType,
+		"origin":     types.StringType,
+	},
+}
+
+var roleCollectionMemberAttributeObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"attribute_name":  types.StringType,
+		"attribute_value": types.StringType,
+		"origin":          types.StringType,
+	},
+}
+
+func roleCollectionMemberUserIsEqual(userA, userB roleCollectionMemberUserType) bool {
+	return userA.Username.Equal(userB.Username) && originMatches(userA.Origin.ValueString(), userB.Origin.ValueString())
+}
+
+func roleCollectionMemberGroupIsEqual(groupA, groupB roleCollectionMemberGroupType) bool {
+	return groupA.Groupname.Equal(groupB.Groupname) && originMatches(groupA.Origin.ValueString(), groupB.Origin.ValueString())
+}
+
+func roleCollectionMemberAttributeIsEqual(attributeA, attributeB roleCollectionMemberAttributeType) bool {
+	return attributeA.AttributeName.Equal(attributeB.AttributeName) && attributeA.AttributeValue.Equal(attributeB.AttributeValue) && originMatches(attributeA.Origin.ValueString(), attributeB.Origin.ValueString())
+}
+
+// roleCollectionMembers is the complete set of users, groups and attributes assigned to a role collection
+type roleCollectionMembers struct {
+	Users      []roleCollectionMemberUserType
+	Groups     []roleCollectionMemberGroupType
+	Attributes []roleCollectionMemberAttributeType
+}
+
+// roleCollectionMembersFromValue maps the user assignments and the attribute mappings of a role collection to its members.
+// Members that correspond to a configured member are taken over as configured, so that alternative spellings returned
+// by the API (e.g. the email instead of the user name or "sap.default" instead of "ldap") do not result in a diff.
+func roleCollectionMembersFromValue(users []xsuaa_authz.UserReference, roleCollection xsuaa_authz.RoleCollection, configured roleCollectionMembers) roleCollectionMembers {
+	members := roleCollectionMembers{
+		Users:      []roleCollectionMemberUserType{},
+		Groups:     []roleCollectionMemberGroupType{},
+		Attributes: []roleCollectionMemberAttributeType{},
+	}
+
+	for _, user := range users {
+		member := roleCollectionMemberUserType{
+			Username: types.StringValue(user.Username),
+			Origin:   types.StringValue(user.Origin),
+		}
+
+		for _, configuredUser := range configured.Users {
+			if (user.Username == configuredUser.Username.ValueString() || user.Email == configuredUser.Username.ValueString()) && originMatches(user.Origin, configuredUser.Origin.ValueString()) {
+				member = configuredUser
+				break
+			}
+		}
+
+		members.Users = append(members.Users, member)
+	}
+
+	for _, mapping := range roleCollection.SamlAttributeAssignment {
+		if mapping.AttributeName == roleCollectionGroupsAttributeName {
+			member := roleCollectionMemberGroupType{
+				Groupname: types.StringValue(mapping.AttributeValue),
+				Origin:    types.StringValue(mapping.IdentityProvider),
+			}
+
+			for _, configuredGroup := range configured.Groups {
+				if roleCollectionMemberGroupIsEqual(member, configuredGroup) {
+					member = configuredGroup
+					break
+				}
+			}
+
+			members.Groups = append(members.Groups, member)
+			continue
+		}
+
+		member := roleCollectionMemberAttributeType{
+			AttributeName:  types.StringValue(mapping.AttributeName),
+			AttributeValue: types.StringValue(mapping.AttributeValue),
+			Origin:         types.StringValue(mapping.IdentityProvider),
+		}
+
+		for _, configuredAttribute := range configured.Attributes {
+			if roleCollectionMemberAttributeIsEqual(member, configuredAttribute) {
+				member = configuredAttribute
+				break
+			}
+		}
+
+		members.Attributes = append(members.Attributes, member)
+	}
+
+	return members
+}
+
+// roleCollectionMembersDifference returns the members of setA that are not part of setB
+func roleCollectionMembersDifference(setA, setB roleCollectionMembers) roleCollectionMembers {
+	return roleCollectionMembers{
+		Users:      tfutils.SetDifference(setA.Users, setB.Users, roleCollectionMemberUserIsEqual),
+		Groups:     tfutils.SetDifference(setA.Groups, setB.Groups, roleCollectionMemberGroupIsEqual),
+		Attributes: tfutils.SetDifference(setA.Attributes, setB.Attributes, roleCollectionMemberAttributeIsEqual),
+	}
+}
+
+// roleCollectionMembersAttributes returns the schema attributes for the members of a role collection that are shared by
+// the subaccount, directory and global account resources
+func roleCollectionMembersAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"users": schema.SetNestedAttribute{
+			MarkdownDescription: "The users that are assigned to the role collection.",
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(roleCollectionMemberUserObjType, []attr.Value{})),
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user_name": schema.StringAttribute{
+						MarkdownDescription: "The username of the user.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 256),
+						},
+					},
+					"origin": schema.StringAttribute{
+						MarkdownDescription: "The identity provider that hosts the user. Use `ldap` for the default identity provider.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"groups": schema.SetNestedAttribute{
+			MarkdownDescription: "The groups of the identity provider that are assigned to the role collection.",
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(roleCollectionMemberGroupObjType, []attr.Value{})),
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"group_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the group.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"origin": schema.StringAttribute{
+						MarkdownDescription: "The identity provider that hosts the group.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"attributes": schema.SetNestedAttribute{
+			MarkdownDescription: "The attribute mappings of the identity provider that are assigned to the role collection.",
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(roleCollectionMemberAttributeObjType, []attr.Value{})),
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"attribute_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the attribute. Groups must be assigned via the attribute `groups`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.NoneOf(roleCollectionGroupsAttributeName),
+						},
+					},
+					"attribute_value": schema.StringAttribute{
+						MarkdownDescription: "The value of the attribute.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"origin": schema.StringAttribute{
+						MarkdownDescription: "The identity provider that hosts the attribute.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// roleCollectionAssignmentOrigin maps the origin returned by the API to the origin used by the role collection
+// assignment resources, which default to "ldap" for the default identity provider
+func roleCollectionAssignmentOrigin(origin string) types.String {
+	if origin == "sap.default" {
+		return types.StringValue("ldap")
+	}
+	return types.StringValue(origin)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -36,22 +37,23 @@ type subaccountDataSourceType struct {
 }
 
 type subaccountType struct {
-	ID                  types.String `tfsdk:"id"`
-	BetaEnabled         types.Bool   `tfsdk:"beta_enabled"`
-	CreatedBy           types.String `tfsdk:"created_by"`
-	CreatedDate         types.String `tfsdk:"created_date"`
-	Description         types.String `tfsdk:"description"`
-	Labels              types.Map    `tfsdk:"labels"`
-	LastModified        types.String `tfsdk:"last_modified"`
-	Name                types.String `tfsdk:"name"`
-	ParentID            types.String `tfsdk:"parent_id"`
-	ParentFeatures      types.Set    `tfsdk:"parent_features"`
-	Region              types.String `tfsdk:"region"`
-	SkipAutoEntitlement types.Bool   `tfsdk:"skip_auto_entitlement"`
-	State               types.String `tfsdk:"state"`
-	Subdomain           types.String `tfsdk:"subdomain"`
-	Usage               types.String `tfsdk:"usage"`
-	ContractStatus      types.String `tfsdk:"contract_status"`
+	ID                  types.String   `tfsdk:"id"`
+	BetaEnabled         types.Bool     `tfsdk:"beta_enabled"`
+	CreatedBy           types.String   `tfsdk:"created_by"`
+	CreatedDate         types.String   `tfsdk:"created_date"`
+	Description         types.String   `tfsdk:"description"`
+	Labels              types.Map      `tfsdk:"labels"`
+	LastModified        types.String   `tfsdk:"last_modified"`
+	Name                types.String   `tfsdk:"name"`
+	ParentID            types.String   `tfsdk:"parent_id"`
+	ParentFeatures      types.Set      `tfsdk:"parent_features"`
+	Region              types.String   `tfsdk:"region"`
+	SkipAutoEntitlement types.Bool     `tfsdk:"skip_auto_entitlement"`
+	State               types.String   `tfsdk:"state"`
+	Subdomain           types.String   `tfsdk:"subdomain"`
+	Usage               types.String   `tfsdk:"usage"`
+	ContractStatus      types.String   `tfsdk:"contract_status"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func subaccountValueFrom(ctx context.Context, value cis.SubaccountResponseObject) (subaccountType, diag.Diagnostics) {
@@ -69,6 +71,7 @@ func subaccountValueFrom(ctx context.Context, value cis.SubaccountResponseObject
 		Subdomain:      types.StringValue(value.Subdomain),
 		Usage:          types.StringValue(value.UsedForProduction),
 		ContractStatus: types.StringValue(value.ContractStatus),
+		Timeouts:       newNullTimeouts(),
 	}
 
 	var diags, diagnostics diag.Diagnostics
@@ -97,6 +100,7 @@ func subaccountListValueFrom(ctx context.Context, value cis.SubaccountResponseOb
 		Subdomain:      types.StringValue(value.Subdomain),
 		Usage:          types.StringValue(value.UsedForProduction),
 		ContractStatus: types.StringValue(value.ContractStatus),
+		Timeouts:       newNullTimeouts(),
 	}
 
 	var diags, diagnostics diag.Diagnostics
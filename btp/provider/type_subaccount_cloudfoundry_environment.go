@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+)
+
+const (
+	cloudfoundryEnvironmentType = "cloudfoundry"
+	cloudfoundryServiceName     = "cloudfoundry"
+)
+
+type subaccountCloudfoundryEnvironmentType struct {
+	SubaccountId   types.String   `tfsdk:"subaccount_id"`
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	PlanName       types.String   `tfsdk:"plan_name"`
+	LandscapeLabel types.String   `tfsdk:"landscape_label"`
+	InstanceName   types.String   `tfsdk:"instance_name"`
+	Memory         types.Int64    `tfsdk:"memory"`
+	ApiEndpoint    types.String   `tfsdk:"api_endpoint"`
+	OrgId          types.String   `tfsdk:"org_id"`
+	OrgName        types.String   `tfsdk:"org_name"`
+	DashboardUrl   types.String   `tfsdk:"dashboard_url"`
+	State          types.String   `tfsdk:"state"`
+	CreatedDate    types.String   `tfsdk:"created_date"`
+	LastModified   types.String   `tfsdk:"last_modified"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// cloudfoundryEnvironmentParameters is the JSON representation of the parameters of a Cloud Foundry environment instance
+type cloudfoundryEnvironmentParameters struct {
+	InstanceName string `json:"instance_name"`
+	Memory       *int64 `json:"memory,omitempty"`
+}
+
+// subaccountCloudfoundryEnvironmentValueFrom maps the environment instance to the resource, the typed parameters are taken from the parameters returned by the API
+func subaccountCloudfoundryEnvironmentValueFrom(value provisioning.EnvironmentInstanceResponseObject) (subaccountCloudfoundryEnvironmentType, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	cloudfoundryEnvironment := subaccountCloudfoundryEnvironmentType{
+		SubaccountId:   types.StringValue(value.SubaccountGUID),
+		Id:             types.StringValue(value.Id),
+		Name:           types.StringValue(value.Name),
+		PlanName:       types.StringValue(value.PlanName),
+		LandscapeLabel: types.StringValue(value.LandscapeLabel),
+		ApiEndpoint:    environmentLabelValue(value.Labels, EnvironmentLabelKeyCfApiUrl),
+		OrgId:          environmentLabelValue(value.Labels, EnvironmentLabelKeyCfOrgId),
+		OrgName:        environmentLabelValue(value.Labels, EnvironmentLabelKeyCfOrgName),
+		DashboardUrl:   types.StringValue(value.DashboardUrl),
+		State:          types.StringValue(value.State),
+		CreatedDate:    timeToValue(value.CreatedDate.Time()),
+		LastModified:   timeToValue(value.ModifiedDate.Time()),
+	}
+
+	var parameters cloudfoundryEnvironmentParameters
+
+	if err := json.Unmarshal([]byte(value.Parameters), &parameters); err != nil {
+		diagnostics.AddError(
+			"Invalid JSON in Environment Instance Parameters",
+			"Could not parse the JSON string found in the Environment Instance Parameters attribute: "+err.Error(),
+		)
+		return cloudfoundryEnvironment, diagnostics
+	}
+
+	cloudfoundryEnvironment.InstanceName = stringNullIfEmpty(parameters.InstanceName)
+	cloudfoundryEnvironment.Memory = types.Int64PointerValue(parameters.Memory)
+
+	return cloudfoundryEnvironment, diagnostics
+}
+
+// resetUnconfiguredCloudfoundryEnvironmentParameters resets the memory quota to null if it is not configured, as the broker
+// fills it with the default quota. Configured parameters are kept as returned by the API, so that a drift is detected.
+func resetUnconfiguredCloudfoundryEnvironmentParameters(target *subaccountCloudfoundryEnvironmentType, configured subaccountCloudfoundryEnvironmentType) {
+	if configured.Memory.IsNull() {
+		target.Memory = configured.Memory
+	}
+}
+
+// cloudfoundryEnvironmentParametersFrom builds the JSON parameters of the Cloud Foundry environment instance from the typed attributes
+func cloudfoundryEnvironmentParametersFrom(plan subaccountCloudfoundryEnvironmentType) (string, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	parametersJSON, err := json.Marshal(cloudfoundryEnvironmentParameters{
+		InstanceName: plan.InstanceName.ValueString(),
+		Memory:       plan.Memory.ValueInt64Pointer(),
+	})
+	if err != nil {
+		diagnostics.AddError("Error Building Cloud Foundry Environment Parameters", err.Error())
+		return "", diagnostics
+	}
+
+	return string(parametersJSON), diagnostics
+}
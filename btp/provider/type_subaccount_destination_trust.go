@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/connectivity"
+)
+
+type subaccountDestinationTrustResourceType struct {
+	SubaccountID         types.String `tfsdk:"subaccount_id"`
+	ID                   types.String `tfsdk:"id"`
+	GeneratePassiveTrust types.Bool   `tfsdk:"generate_passive_trust"`
+	RotationTrigger      types.String `tfsdk:"rotation_trigger"`
+	ActiveTrust          types.Object `tfsdk:"active_trust"`
+	PassiveTrust         types.Object `tfsdk:"passive_trust"`
+}
+
+type subaccountDestinationTrustCertificateType struct {
+	Name                types.String `tfsdk:"name"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	GeneratedOn         types.String `tfsdk:"generated_on"`
+	Expiration          types.String `tfsdk:"expiration"`
+	X509PublicKeyBase64 types.String `tfsdk:"x509_public_key_base64"`
+}
+
+var subaccountDestinationTrustCertificateObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":                   types.StringType,
+		"base_url":               types.StringType,
+		"generated_on":           types.StringType,
+		"expiration":             types.StringType,
+		"x509_public_key_base64": types.StringType,
+	},
+}
+
+// subaccountDestinationTrustCertificateValueFrom maps a destination trust to its terraform object value, a missing trust is mapped to null
+func subaccountDestinationTrustCertificateValueFrom(ctx context.Context, value *connectivity.DestinationTrust) (types.Object, diag.Diagnostics) {
+	if value == nil {
+		return types.ObjectNull(subaccountDestinationTrustCertificateObjType.AttrTypes), diag.Diagnostics{}
+	}
+
+	certificate := subaccountDestinationTrustCertificateType{
+		Name:                types.StringValue(value.Name),
+		BaseURL:             types.StringValue(value.BaseURL),
+		GeneratedOn:         types.StringValue(value.GeneratedOn),
+		Expiration:          types.StringValue(time.UnixMilli(value.Expiration).UTC().Format(time.RFC3339Nano)),
+		X509PublicKeyBase64: types.StringValue(value.X509PublicKeyBase64),
+	}
+
+	return types.ObjectValueFrom(ctx, subaccountDestinationTrustCertificateObjType.AttrTypes, certificate)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -18,17 +19,18 @@ const entitlementCategoryPlatformBasedApplication = "PLATFORM"
 const entitlementCategoryEnvironment = "ENVIRONMENT"
 
 type subaccountEntitlementType struct {
-	SubaccountId         types.String `tfsdk:"subaccount_id"`
-	Id                   types.String `tfsdk:"id"`
-	ServiceName          types.String `tfsdk:"service_name"`
-	PlanName             types.String `tfsdk:"plan_name"`
-	Category             types.String `tfsdk:"category"`
-	PlanId               types.String `tfsdk:"plan_id"`
-	Amount               types.Int64  `tfsdk:"amount"`
-	PlanUniqueIdentifier types.String `tfsdk:"plan_unique_identifier"`
-	State                types.String `tfsdk:"state"`
-	CreatedDate          types.String `tfsdk:"created_date"`
-	LastModified         types.String `tfsdk:"last_modified"`
+	SubaccountId         types.String   `tfsdk:"subaccount_id"`
+	Id                   types.String   `tfsdk:"id"`
+	ServiceName          types.String   `tfsdk:"service_name"`
+	PlanName             types.String   `tfsdk:"plan_name"`
+	Category             types.String   `tfsdk:"category"`
+	PlanId               types.String   `tfsdk:"plan_id"`
+	Amount               types.Int64    `tfsdk:"amount"`
+	PlanUniqueIdentifier types.String   `tfsdk:"plan_unique_identifier"`
+	State                types.String   `tfsdk:"state"`
+	CreatedDate          types.String   `tfsdk:"created_date"`
+	LastModified         types.String   `tfsdk:"last_modified"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func subaccountEntitlementValueFrom(ctx context.Context, value btpcli.UnfoldedAssignment) (subaccountEntitlementType, diag.Diagnostics) {
@@ -49,6 +51,7 @@ func subaccountEntitlementValueFrom(ctx context.Context, value btpcli.UnfoldedAs
 	subaccountEntitlement.State = types.StringValue(value.Assignment.EntityState)
 	subaccountEntitlement.LastModified = timeToValue(value.Assignment.ModifiedDate.Time())
 	subaccountEntitlement.CreatedDate = timeToValue(value.Assignment.CreatedDate.Time())
+	subaccountEntitlement.Timeouts = newNullTimeouts()
 
 	return subaccountEntitlement, diag.Diagnostics{}
 }
@@ -86,6 +89,7 @@ func subaccountEntitlementListValueFrom(service cis_entitlements.EntitledService
 		PlanUniqueIdentifier: types.StringValue(servicePlan.UniqueIdentifier),
 		Category:             types.StringValue(servicePlan.Category),
 		PlanId:               types.StringValue(servicePlan.UniqueIdentifier),
+		Timeouts:             newNullTimeouts(),
 	}
 
 	if isTransferAmountRequired(resEnt.Category.ValueString()) {
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli"
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/cis_entitlements"
+)
+
+type subaccountEntitlementsType struct {
+	SubaccountId types.String   `tfsdk:"subaccount_id"`
+	Id           types.String   `tfsdk:"id"`
+	Entitlements types.Set      `tfsdk:"entitlements"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type subaccountEntitlementsPlanType struct {
+	ServiceName types.String `tfsdk:"service_name"`
+	PlanName    types.String `tfsdk:"plan_name"`
+	Amount      types.Int64  `tfsdk:"amount"`
+}
+
+var subaccountEntitlementsPlanObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"service_name": types.StringType,
+		"plan_name":    types.StringType,
+		"amount":       types.Int64Type,
+	},
+}
+
+// subaccountEntitlementsAssignment is an entitlement that is assigned to a subaccount
+type subaccountEntitlementsAssignment struct {
+	ServiceName  string
+	PlanName     string
+	Category     string
+	Amount       int64
+	State        string
+	StateMessage string
+}
+
+func (a subaccountEntitlementsAssignment) key() string {
+	return subaccountEntitlementsKey(a.ServiceName, a.PlanName)
+}
+
+func subaccountEntitlementsKey(serviceName string, planName string) string {
+	return serviceName + ":" + planName
+}
+
+// subaccountEntitlementsAssignmentsFrom extracts the entitlements assigned to the subaccount. Entitlements that are
+// assigned automatically, e.g. by a directory with auto-assignment, are not managed by the resource and therefore skipped.
+func subaccountEntitlementsAssignmentsFrom(value cis_entitlements.EntitledAndAssignedServicesResponseObject, subaccountId string) map[string]subaccountEntitlementsAssignment {
+	assignments := map[string]subaccountEntitlementsAssignment{}
+
+	for _, service := range value.AssignedServices {
+		for _, plan := range service.ServicePlans {
+			for _, assignment := range plan.AssignmentInfo {
+				if assignment.EntityType != "SUBACCOUNT" || assignment.EntityId != subaccountId || assignment.AutoAssigned {
+					continue
+				}
+
+				entry := subaccountEntitlementsAssignment{
+					ServiceName:  service.Name,
+					PlanName:     plan.Name,
+					Category:     plan.Category,
+					State:        assignment.EntityState,
+					StateMessage: assignment.StateMessage,
+				}
+
+				if isTransferAmountRequired(plan.Category) {
+					entry.Amount = int64(assignment.Amount)
+				}
+
+				assignments[entry.key()] = entry
+			}
+		}
+	}
+
+	return assignments
+}
+
+func subaccountEntitlementsValueFrom(ctx context.Context, assignments map[string]subaccountEntitlementsAssignment) (types.Set, diag.Diagnostics) {
+	plans := make([]subaccountEntitlementsPlanType, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		plan := subaccountEntitlementsPlanType{
+			ServiceName: types.StringValue(assignment.ServiceName),
+			PlanName:    types.StringValue(assignment.PlanName),
+			Amount:      types.Int64Null(),
+		}
+
+		if isTransferAmountRequired(assignment.Category) {
+			plan.Amount = types.Int64Value(assignment.Amount)
+		}
+
+		plans = append(plans, plan)
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return subaccountEntitlementsKey(plans[i].ServiceName.ValueString(), plans[i].PlanName.ValueString()) < subaccountEntitlementsKey(plans[j].ServiceName.ValueString(), plans[j].PlanName.ValueString())
+	})
+
+	return types.SetValueFrom(ctx, subaccountEntitlementsPlanObjType, plans)
+}
+
+func subaccountEntitlementsPlansFrom(ctx context.Context, value types.Set) (map[string]subaccountEntitlementsPlanType, diag.Diagnostics) {
+	var plans []subaccountEntitlementsPlanType
+	diags := value.ElementsAs(ctx, &plans, false)
+
+	planMap := make(map[string]subaccountEntitlementsPlanType, len(plans))
+	for _, plan := range plans {
+		planMap[subaccountEntitlementsKey(plan.ServiceName.ValueString(), plan.PlanName.ValueString())] = plan
+	}
+
+	return planMap, diags
+}
+
+// buildSubaccountEntitlementsPayload determines the changes that are needed to get from the current to the desired
+// entitlements of the subaccount. Plans with an amount are assigned with a quota, plans without an amount are enabled.
+// Entitlements that are not desired are removed. Returns an empty list if no change is needed.
+func buildSubaccountEntitlementsPayload(subaccountId string, current map[string]subaccountEntitlementsAssignment, desired map[string]subaccountEntitlementsPlanType) []btpcli.SubaccountServicePlanAssignment {
+	payload := []btpcli.SubaccountServicePlanAssignment{}
+
+	addAssignment := func(serviceName string, planName string, assignment btpcli.SubaccountServicePlanAssignmentInfo) {
+		assignment.SubaccountGUID = subaccountId
+		payload = append(payload, btpcli.SubaccountServicePlanAssignment{
+			ServiceName:     serviceName,
+			ServicePlanName: planName,
+			AssignmentInfo:  []btpcli.SubaccountServicePlanAssignmentInfo{assignment},
+		})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		plan := desired[key]
+		existing, isAssigned := current[key]
+
+		if plan.Amount.IsNull() {
+			if !isAssigned {
+				enable := true
+				addAssignment(plan.ServiceName.ValueString(), plan.PlanName.ValueString(), btpcli.SubaccountServicePlanAssignmentInfo{Enable: &enable})
+			}
+			continue
+		}
+
+		if !isAssigned || existing.Amount != plan.Amount.ValueInt64() {
+			amount := float64(plan.Amount.ValueInt64())
+			addAssignment(plan.ServiceName.ValueString(), plan.PlanName.ValueString(), btpcli.SubaccountServicePlanAssignmentInfo{Amount: &amount})
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, isDesired := desired[key]; isDesired {
+			continue
+		}
+
+		existing := current[key]
+		if isTransferAmountRequired(existing.Category) {
+			amount := float64(0)
+			addAssignment(existing.ServiceName, existing.PlanName, btpcli.SubaccountServicePlanAssignmentInfo{Amount: &amount})
+		} else {
+			enable := false
+			addAssignment(existing.ServiceName, existing.PlanName, btpcli.SubaccountServicePlanAssignmentInfo{Enable: &enable})
+		}
+	}
+
+	return payload
+}
+
+// subaccountEntitlementsTargetStateReached checks whether the current entitlements of the subaccount match the desired ones.
+// An error is returned if the processing of an entitlement failed.
+func subaccountEntitlementsTargetStateReached(current map[string]subaccountEntitlementsAssignment, desired map[string]subaccountEntitlementsPlanType) (bool, error) {
+	var failed []string
+	reached := true
+
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		existing := current[key]
+
+		if existing.State == cis_entitlements.StateProcessingFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", key, existing.StateMessage))
+			continue
+		}
+
+		plan, isDesired := desired[key]
+		if !isDesired || existing.State != cis_entitlements.StateOK {
+			reached = false
+			continue
+		}
+
+		if !plan.Amount.IsNull() && existing.Amount != plan.Amount.ValueInt64() {
+			reached = false
+		}
+	}
+
+	if len(failed) > 0 {
+		return false, errors.New("the processing of the following entitlements failed: " + strings.Join(failed, "; "))
+	}
+
+	for key := range desired {
+		if _, isAssigned := current[key]; !isAssigned {
+			reached = false
+		}
+	}
+
+	return reached, nil
+}
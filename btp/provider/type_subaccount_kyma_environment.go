@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/provisioning"
+)
+
+const (
+	kymaEnvironmentType = "kyma"
+	kymaServiceName     = "kymaruntime"
+)
+
+type subaccountKymaEnvironmentType struct {
+	SubaccountId   types.String   `tfsdk:"subaccount_id"`
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	PlanName       types.String   `tfsdk:"plan_name"`
+	LandscapeLabel types.String   `tfsdk:"landscape_label"`
+	Region         types.String   `tfsdk:"region"`
+	MachineType    types.String   `tfsdk:"machine_type"`
+	AutoscalerMin  types.Int64    `tfsdk:"autoscaler_min"`
+	AutoscalerMax  types.Int64    `tfsdk:"autoscaler_max"`
+	Oidc           types.Object   `tfsdk:"oidc"`
+	Administrators types.Set      `tfsdk:"administrators"`
+	Modules        types.List     `tfsdk:"modules"`
+	KubeconfigUrl  types.String   `tfsdk:"kubeconfig_url"`
+	ApiServerUrl   types.String   `tfsdk:"api_server_url"`
+	DashboardUrl   types.String   `tfsdk:"dashboard_url"`
+	State          types.String   `tfsdk:"state"`
+	CreatedDate    types.String   `tfsdk:"created_date"`
+	LastModified   types.String   `tfsdk:"last_modified"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type subaccountKymaEnvironmentOidcType struct {
+	ClientId       types.String `tfsdk:"client_id"`
+	IssuerUrl      types.String `tfsdk:"issuer_url"`
+	GroupsClaim    types.String `tfsdk:"groups_claim"`
+	UsernameClaim  types.String `tfsdk:"username_claim"`
+	UsernamePrefix types.String `tfsdk:"username_prefix"`
+	SigningAlgs    types.Set    `tfsdk:"signing_algs"`
+}
+
+var subaccountKymaEnvironmentOidcObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"client_id":       types.StringType,
+		"issuer_url":      types.StringType,
+		"groups_claim":    types.StringType,
+		"username_claim":  types.StringType,
+		"username_prefix": types.StringType,
+		"signing_algs":    types.SetType{ElemType: types.StringType},
+	},
+}
+
+type subaccountKymaEnvironmentModuleType struct {
+	Name                 types.String `tfsdk:"name"`
+	Channel              types.String `tfsdk:"channel"`
+	CustomResourcePolicy types.String `tfsdk:"custom_resource_policy"`
+}
+
+var subaccountKymaEnvironmentModuleObjType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":                   types.StringType,
+		"channel":                types.StringType,
+		"custom_resource_policy": types.StringType,
+	},
+}
+
+// kymaEnvironmentParameters is the JSON representation of the parameters of a Kyma environment instance
+type kymaEnvironmentParameters struct {
+	Name           string                  `json:"name"`
+	Region         string                  `json:"region,omitempty"`
+	MachineType    string                  `json:"machineType,omitempty"`
+	AutoScalerMin  *int64                  `json:"autoScalerMin,omitempty"`
+	AutoScalerMax  *int64                  `json:"autoScalerMax,omitempty"`
+	Oidc           *kymaEnvironmentOidc    `json:"oidc,omitempty"`
+	Administrators []string                `json:"administrators,omitempty"`
+	Modules        *kymaEnvironmentModules `json:"modules,omitempty"`
+}
+
+type kymaEnvironmentOidc struct {
+	ClientID       string   `json:"clientID"`
+	IssuerURL      string   `json:"issuerURL"`
+	GroupsClaim    string   `json:"groupsClaim,omitempty"`
+	UsernameClaim  string   `json:"usernameClaim,omitempty"`
+	UsernamePrefix string   `json:"usernamePrefix,omitempty"`
+	SigningAlgs    []string `json:"signingAlgs,omitempty"`
+}
+
+type kymaEnvironmentModules struct {
+	Default *bool                   `json:"default,omitempty"`
+	List    []kymaEnvironmentModule `json:"list"`
+}
+
+type kymaEnvironmentModule struct {
+	Name                 string `json:"name"`
+	Channel              string `json:"channel,omitempty"`
+	CustomResourcePolicy string `json:"customResourcePolicy,omitempty"`
+}
+
+// subaccountKymaEnvironmentValueFrom maps the environment instance to the resource, the typed parameters are taken from the parameters returned by the API
+func subaccountKymaEnvironmentValueFrom(ctx context.Context, value provisioning.EnvironmentInstanceResponseObject) (subaccountKymaEnvironmentType, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	kymaEnvironment := subaccountKymaEnvironmentType{
+		SubaccountId:   types.StringValue(value.SubaccountGUID),
+		Id:             types.StringValue(value.Id),
+		Name:           types.StringValue(value.Name),
+		PlanName:       types.StringValue(value.PlanName),
+		LandscapeLabel: types.StringValue(value.LandscapeLabel),
+		KubeconfigUrl:  environmentLabelValue(value.Labels, EnvironmentLabelKeyKymaKubeconfigUrl),
+		ApiServerUrl:   environmentLabelValue(value.Labels, EnvironmentLabelKeyKymaApiServerUrl),
+		DashboardUrl:   types.StringValue(value.DashboardUrl),
+		State:          types.StringValue(value.State),
+		CreatedDate:    timeToValue(value.CreatedDate.Time()),
+		LastModified:   timeToValue(value.ModifiedDate.Time()),
+	}
+
+	var parameters kymaEnvironmentParameters
+
+	if err := json.Unmarshal([]byte(value.Parameters), &parameters); err != nil {
+		diagnostics.AddError(
+			"Invalid JSON in Environment Instance Parameters",
+			"Could not parse the JSON string found in the Environment Instance Parameters attribute: "+err.Error(),
+		)
+		return kymaEnvironment, diagnostics
+	}
+
+	kymaEnvironment.Region = stringNullIfEmpty(parameters.Region)
+	kymaEnvironment.MachineType = stringNullIfEmpty(parameters.MachineType)
+	kymaEnvironment.AutoscalerMin = types.Int64PointerValue(parameters.AutoScalerMin)
+	kymaEnvironment.AutoscalerMax = types.Int64PointerValue(parameters.AutoScalerMax)
+
+	kymaEnvironment.Oidc = types.ObjectNull(subaccountKymaEnvironmentOidcObjType.AttrTypes)
+	if parameters.Oidc != nil {
+		oidc := subaccountKymaEnvironmentOidcType{
+			ClientId:       types.StringValue(parameters.Oidc.ClientID),
+			IssuerUrl:      types.StringValue(parameters.Oidc.IssuerURL),
+			GroupsClaim:    stringNullIfEmpty(parameters.Oidc.GroupsClaim),
+			UsernameClaim:  stringNullIfEmpty(parameters.Oidc.UsernameClaim),
+			UsernamePrefix: stringNullIfEmpty(parameters.Oidc.UsernamePrefix),
+			SigningAlgs:    types.SetNull(types.StringType),
+		}
+
+		if len(parameters.Oidc.SigningAlgs) > 0 {
+			oidc.SigningAlgs, diags = types.SetValueFrom(ctx, types.StringType, parameters.Oidc.SigningAlgs)
+			diagnostics.Append(diags...)
+		}
+
+		kymaEnvironment.Oidc, diags = types.ObjectValueFrom(ctx, subaccountKymaEnvironmentOidcObjType.AttrTypes, oidc)
+		diagnostics.Append(diags...)
+	}
+
+	kymaEnvironment.Administrators = types.SetNull(types.StringType)
+	if len(parameters.Administrators) > 0 {
+		kymaEnvironment.Administrators, diags = types.SetValueFrom(ctx, types.StringType, parameters.Administrators)
+		diagnostics.Append(diags...)
+	}
+
+	// the default modules are represented by a null value
+	kymaEnvironment.Modules = types.ListNull(subaccountKymaEnvironmentModuleObjType)
+	if parameters.Modules != nil && parameters.Modules.List != nil {
+		modules := make([]subaccountKymaEnvironmentModuleType, 0, len(parameters.Modules.List))
+		for _, module := range parameters.Modules.List {
+			modules = append(modules, subaccountKymaEnvironmentModuleType{
+				Name:                 types.StringValue(module.Name),
+				Channel:              stringNullIfEmpty(module.Channel),
+				CustomResourcePolicy: stringNullIfEmpty(module.CustomResourcePolicy),
+			})
+		}
+
+		kymaEnvironment.Modules, diags = types.ListValueFrom(ctx, subaccountKymaEnvironmentModuleObjType, modules)
+		diagnostics.Append(diags...)
+	}
+
+	return kymaEnvironment, diagnostics
+}
+
+// resetUnconfiguredKymaEnvironmentParameters resets the typed parameters that are not configured to null, as the broker
+// fills them with defaults. Configured parameters are kept as returned by the API, so that a drift is detected.
+func resetUnconfiguredKymaEnvironmentParameters(ctx context.Context, target *subaccountKymaEnvironmentType, configured subaccountKymaEnvironmentType) (diags diag.Diagnostics) {
+	var d diag.Diagnostics
+
+	if configured.Region.IsNull() {
+		target.Region = configured.Region
+	}
+
+	if configured.MachineType.IsNull() {
+		target.MachineType = configured.MachineType
+	}
+
+	if configured.AutoscalerMin.IsNull() {
+		target.AutoscalerMin = configured.AutoscalerMin
+	}
+
+	if configured.AutoscalerMax.IsNull() {
+		target.AutoscalerMax = configured.AutoscalerMax
+	}
+
+	if configured.Administrators.IsNull() {
+		target.Administrators = configured.Administrators
+	}
+
+	if configured.Oidc.IsNull() {
+		target.Oidc = configured.Oidc
+	} else if !target.Oidc.IsNull() {
+		var targetOidc, configuredOidc subaccountKymaEnvironmentOidcType
+		diags.Append(target.Oidc.As(ctx, &targetOidc, basetypes.ObjectAsOptions{})...)
+		diags.Append(configured.Oidc.As(ctx, &configuredOidc, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+
+		if configuredOidc.GroupsClaim.IsNull() {
+			targetOidc.GroupsClaim = configuredOidc.GroupsClaim
+		}
+
+		if configuredOidc.UsernameClaim.IsNull() {
+			targetOidc.UsernameClaim = configuredOidc.UsernameClaim
+		}
+
+		if configuredOidc.UsernamePrefix.IsNull() {
+			targetOidc.UsernamePrefix = configuredOidc.UsernamePrefix
+		}
+
+		if configuredOidc.SigningAlgs.IsNull() {
+			targetOidc.SigningAlgs = configuredOidc.SigningAlgs
+		}
+
+		target.Oidc, d = types.ObjectValueFrom(ctx, subaccountKymaEnvironmentOidcObjType.AttrTypes, targetOidc)
+		diags.Append(d...)
+	}
+
+	// the default modules are represented by a null value, the broker may return them as an explicit list
+	if configured.Modules.IsNull() {
+		target.Modules = configured.Modules
+		return
+	}
+
+	if target.Modules.IsNull() {
+		return
+	}
+
+	var targetModules, configuredModules []subaccountKymaEnvironmentModuleType
+	diags.Append(target.Modules.ElementsAs(ctx, &targetModules, false)...)
+	diags.Append(configured.Modules.ElementsAs(ctx, &configuredModules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, targetModule := range targetModules {
+		for _, configuredModule := range configuredModules {
+			if !targetModule.Name.Equal(configuredModule.Name) {
+				continue
+			}
+
+			if configuredModule.Channel.IsNull() {
+				targetModules[i].Channel = configuredModule.Channel
+			}
+
+			if configuredModule.CustomResourcePolicy.IsNull() {
+				targetModules[i].CustomResourcePolicy = configuredModule.CustomResourcePolicy
+			}
+		}
+	}
+
+	target.Modules, d = types.ListValueFrom(ctx, subaccountKymaEnvironmentModuleObjType, targetModules)
+	diags.Append(d...)
+
+	return
+}
+
+// kymaEnvironmentParametersFrom builds the JSON parameters of the Kyma environment instance from the typed attributes
+func kymaEnvironmentParametersFrom(ctx context.Context, plan subaccountKymaEnvironmentType) (string, diag.Diagnostics) {
+	var diags, diagnostics diag.Diagnostics
+
+	parameters := kymaEnvironmentParameters{
+		Name:          plan.Name.ValueString(),
+		Region:        plan.Region.ValueString(),
+		MachineType:   plan.MachineType.ValueString(),
+		AutoScalerMin: plan.AutoscalerMin.ValueInt64Pointer(),
+		AutoScalerMax: plan.AutoscalerMax.ValueInt64Pointer(),
+	}
+
+	if !plan.Oidc.IsNull() && !plan.Oidc.IsUnknown() {
+		var oidc subaccountKymaEnvironmentOidcType
+		diags = plan.Oidc.As(ctx, &oidc, basetypes.ObjectAsOptions{})
+		diagnostics.Append(diags...)
+
+		parameters.Oidc = &kymaEnvironmentOidc{
+			ClientID:       oidc.ClientId.ValueString(),
+			IssuerURL:      oidc.IssuerUrl.ValueString(),
+			GroupsClaim:    oidc.GroupsClaim.ValueString(),
+			UsernameClaim:  oidc.UsernameClaim.ValueString(),
+			UsernamePrefix: oidc.UsernamePrefix.ValueString(),
+		}
+
+		if !oidc.SigningAlgs.IsNull() {
+			diags = oidc.SigningAlgs.ElementsAs(ctx, &parameters.Oidc.SigningAlgs, false)
+			diagnostics.Append(diags...)
+		}
+	}
+
+	if !plan.Administrators.IsNull() {
+		diags = plan.Administrators.ElementsAs(ctx, &parameters.Administrators, false)
+		diagnostics.Append(diags...)
+	}
+
+	if !plan.Modules.IsNull() {
+		var modules []subaccountKymaEnvironmentModuleType
+		diags = plan.Modules.ElementsAs(ctx, &modules, false)
+		diagnostics.Append(diags...)
+
+		// an empty list is sent on purpose to disable the default modules
+		parameters.Modules = &kymaEnvironmentModules{List: make([]kymaEnvironmentModule, 0, len(modules))}
+		for _, module := range modules {
+			parameters.Modules.List = append(parameters.Modules.List, kymaEnvironmentModule{
+				Name:                 module.Name.ValueString(),
+				Channel:              module.Channel.ValueString(),
+				CustomResourcePolicy: module.CustomResourcePolicy.ValueString(),
+			})
+		}
+	}
+
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		diagnostics.AddError("Error Building Kyma Environment Parameters", err.Error())
+		return "", diagnostics
+	}
+
+	return string(parametersJSON), diagnostics
+}
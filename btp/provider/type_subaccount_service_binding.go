@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -12,19 +15,78 @@ import (
 )
 
 type subaccountServiceBindingType struct {
-	SubaccountId      types.String         `tfsdk:"subaccount_id"`
-	ServiceInstanceId types.String         `tfsdk:"service_instance_id"`
-	Name              types.String         `tfsdk:"name"`
-	Parameters        jsontypes.Normalized `tfsdk:"parameters"`
-	Id                types.String         `tfsdk:"id"`
-	Ready             types.Bool           `tfsdk:"ready"`
-	Context           types.String         `tfsdk:"context"`
-	BindResource      types.Map            `tfsdk:"bind_resource"`
-	Credentials       types.String         `tfsdk:"credentials"`
-	State             types.String         `tfsdk:"state"`
-	CreatedDate       types.String         `tfsdk:"created_date"`
-	LastModified      types.String         `tfsdk:"last_modified"`
-	Labels            types.Map            `tfsdk:"labels"`
+	SubaccountId      types.String                          `tfsdk:"subaccount_id"`
+	ServiceInstanceId types.String                          `tfsdk:"service_instance_id"`
+	Name              types.String                          `tfsdk:"name"`
+	Parameters        jsontypes.Normalized                  `tfsdk:"parameters"`
+	Id                types.String                          `tfsdk:"id"`
+	Ready             types.Bool                            `tfsdk:"ready"`
+	Context           types.String                          `tfsdk:"context"`
+	BindResource      types.Map                             `tfsdk:"bind_resource"`
+	Credentials       types.String                          `tfsdk:"credentials"`
+	State             types.String                          `tfsdk:"state"`
+	CreatedDate       types.String                          `tfsdk:"created_date"`
+	LastModified      types.String                          `tfsdk:"last_modified"`
+	Labels            types.Map                             `tfsdk:"labels"`
+	Rotation          *subaccountServiceBindingRotationType `tfsdk:"rotation"`
+	RotatedBindings   types.List                            `tfsdk:"rotated_bindings"`
+}
+
+type subaccountServiceBindingRotationType struct {
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Frequency types.String `tfsdk:"frequency"`
+	Overlap   types.String `tfsdk:"overlap"`
+}
+
+type subaccountServiceBindingRotatedBindingType struct {
+	Id        types.String `tfsdk:"id"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+var rotatedServiceBindingObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"expires_at": types.StringType,
+	},
+}
+
+// serviceBindingRotationDue checks if the binding that was created at the given date must be replaced by a new one
+func serviceBindingRotationDue(rotation *subaccountServiceBindingRotationType, createdDate types.String, now time.Time) bool {
+	if rotation == nil || !rotation.Enabled.ValueBool() || createdDate.IsNull() || createdDate.IsUnknown() {
+		return false
+	}
+
+	frequency, err := time.ParseDuration(rotation.Frequency.ValueString())
+	if err != nil {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, createdDate.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !created.Add(frequency).After(now)
+}
+
+// rotatedServiceBindingExpired checks if the overlap period of a rotated binding is over
+func rotatedServiceBindingExpired(rotatedBinding subaccountServiceBindingRotatedBindingType, now time.Time) (bool, error) {
+	expiresAt, err := time.Parse(time.RFC3339, rotatedBinding.ExpiresAt.ValueString())
+	if err != nil {
+		return false, err
+	}
+
+	return !expiresAt.After(now), nil
+}
+
+// serviceBindingPlannedRotation is stored in the private state during the plan, so that the apply only executes the planned rotation and deletions
+type serviceBindingPlannedRotation struct {
+	Rotate          bool     `json:"rotate"`
+	ExpiredBindings []string `json:"expired_bindings"`
+}
+
+func rotatedServiceBindingName(name string, now time.Time) string {
+	return fmt.Sprintf("%s-%d", name, now.Unix())
 }
 
 func subaccountServiceBindingValueFrom(ctx context.Context, value servicemanager.ServiceBindingResponseObject) (subaccountServiceBindingType, diag.Diagnostics) {
@@ -38,6 +100,7 @@ func subaccountServiceBindingValueFrom(ctx context.Context, value servicemanager
 		Credentials:       types.StringValue(string(value.Credentials)),
 		CreatedDate:       timeToValue(value.CreatedAt),
 		LastModified:      timeToValue(value.UpdatedAt),
+		RotatedBindings:   types.ListNull(rotatedServiceBindingObjectType),
 	}
 
 	// CREATE and GET repsonses might differ - safeguarding nil reference of LastOperation
@@ -58,3 +121,39 @@ func subaccountServiceBindingValueFrom(ctx context.Context, value servicemanager
 
 	return serviceBinding, diagnostics
 }
+
+type subaccountServiceBindingDataSourceType struct {
+	SubaccountId      types.String         `tfsdk:"subaccount_id"`
+	ServiceInstanceId types.String         `tfsdk:"service_instance_id"`
+	Name              types.String         `tfsdk:"name"`
+	Parameters        jsontypes.Normalized `tfsdk:"parameters"`
+	Id                types.String         `tfsdk:"id"`
+	Ready             types.Bool           `tfsdk:"ready"`
+	Context           types.String         `tfsdk:"context"`
+	BindResource      types.Map            `tfsdk:"bind_resource"`
+	Credentials       types.String         `tfsdk:"credentials"`
+	State             types.String         `tfsdk:"state"`
+	CreatedDate       types.String         `tfsdk:"created_date"`
+	LastModified      types.String         `tfsdk:"last_modified"`
+	Labels            types.Map            `tfsdk:"labels"`
+}
+
+func subaccountServiceBindingDataSourceValueFrom(ctx context.Context, value servicemanager.ServiceBindingResponseObject) (subaccountServiceBindingDataSourceType, diag.Diagnostics) {
+	serviceBinding, diags := subaccountServiceBindingValueFrom(ctx, value)
+
+	return subaccountServiceBindingDataSourceType{
+		SubaccountId:      serviceBinding.SubaccountId,
+		ServiceInstanceId: serviceBinding.ServiceInstanceId,
+		Name:              serviceBinding.Name,
+		Parameters:        serviceBinding.Parameters,
+		Id:                serviceBinding.Id,
+		Ready:             serviceBinding.Ready,
+		Context:           serviceBinding.Context,
+		BindResource:      serviceBinding.BindResource,
+		Credentials:       serviceBinding.Credentials,
+		State:             serviceBinding.State,
+		CreatedDate:       serviceBinding.CreatedDate,
+		LastModified:      serviceBinding.LastModified,
+		Labels:            serviceBinding.Labels,
+	}, diags
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -12,34 +13,94 @@ import (
 )
 
 type subaccountSubscriptionType struct {
-	SubaccountId              types.String         `tfsdk:"subaccount_id"`
-	Id                        types.String         `tfsdk:"id"`
-	AppName                   types.String         `tfsdk:"app_name"`
-	PlanName                  types.String         `tfsdk:"plan_name"`
-	Parameters                jsontypes.Normalized `tfsdk:"parameters"`
-	AdditionalPlanFeatures    types.Set            `tfsdk:"additional_plan_features"`
-	AppId                     types.String         `tfsdk:"app_id"`
-	AuthenticationProvider    types.String         `tfsdk:"authentication_provider"`
-	Category                  types.String         `tfsdk:"category"`
-	CommercialAppName         types.String         `tfsdk:"commercial_app_name"`
-	CreatedDate               types.String         `tfsdk:"created_date"`
-	CustomerDeveloped         types.Bool           `tfsdk:"customer_developed"`
-	Description               types.String         `tfsdk:"description"`
-	DisplayName               types.String         `tfsdk:"display_name"`
-	FormationSolutionName     types.String         `tfsdk:"formation_solution_name"`
-	GlobalAccountId           types.String         `tfsdk:"globalaccount_id"`
-	Labels                    types.Map            `tfsdk:"labels"`
-	LastModified              types.String         `tfsdk:"last_modified"`
-	PlatformEntityId          types.String         `tfsdk:"platform_entity_id"`
-	Quota                     types.Int64          `tfsdk:"quota"`
-	State                     types.String         `tfsdk:"state"`
-	SubscribedSubaccountId    types.String         `tfsdk:"subscribed_subaccount_id"`
-	SubscribedTenantId        types.String         `tfsdk:"subscribed_tenant_id"`
-	SubscriptionUrl           types.String         `tfsdk:"subscription_url"`
-	SupportsParametersUpdates types.Bool           `tfsdk:"supports_parameters_updates"`
-	SupportsPlanUpdates       types.Bool           `tfsdk:"supports_plan_updates"`
-	TenantId                  types.String         `tfsdk:"tenant_id"`
-	Timeouts                  timeouts.Value       `tfsdk:"timeouts"`
+	SubaccountId              types.String                                `tfsdk:"subaccount_id"`
+	Id                        types.String                                `tfsdk:"id"`
+	AppName                   types.String                                `tfsdk:"app_name"`
+	PlanName                  types.String                                `tfsdk:"plan_name"`
+	Parameters                jsontypes.Normalized                        `tfsdk:"parameters"`
+	AdditionalPlanFeatures    types.Set                                   `tfsdk:"additional_plan_features"`
+	AppId                     types.String                                `tfsdk:"app_id"`
+	AuthenticationProvider    types.String                                `tfsdk:"authentication_provider"`
+	Category                  types.String                                `tfsdk:"category"`
+	CommercialAppName         types.String                                `tfsdk:"commercial_app_name"`
+	CreatedDate               types.String                                `tfsdk:"created_date"`
+	CustomerDeveloped         types.Bool                                  `tfsdk:"customer_developed"`
+	Description               types.String                                `tfsdk:"description"`
+	DisplayName               types.String                                `tfsdk:"display_name"`
+	FormationSolutionName     types.String                                `tfsdk:"formation_solution_name"`
+	GlobalAccountId           types.String                                `tfsdk:"globalaccount_id"`
+	Labels                    types.Map                                   `tfsdk:"labels"`
+	LastModified              types.String                                `tfsdk:"last_modified"`
+	PlatformEntityId          types.String                                `tfsdk:"platform_entity_id"`
+	Quota                     types.Int64                                 `tfsdk:"quota"`
+	State                     types.String                                `tfsdk:"state"`
+	SubscribedSubaccountId    types.String                                `tfsdk:"subscribed_subaccount_id"`
+	SubscribedTenantId        types.String                                `tfsdk:"subscribed_tenant_id"`
+	SubscriptionUrl           types.String                                `tfsdk:"subscription_url"`
+	SupportsParametersUpdates types.Bool                                  `tfsdk:"supports_parameters_updates"`
+	SupportsPlanUpdates       types.Bool                                  `tfsdk:"supports_plan_updates"`
+	TenantId                  types.String                                `tfsdk:"tenant_id"`
+	Dependencies              types.List                                  `tfsdk:"dependencies"`
+	DependencyUpdate          *subaccountSubscriptionDependencyUpdateType `tfsdk:"dependency_update"`
+	Timeouts                  timeouts.Value                              `tfsdk:"timeouts"`
+}
+
+type subaccountSubscriptionDependencyUpdateType struct {
+	AppProviderCustomProperties jsontypes.Normalized `tfsdk:"app_provider_custom_properties"`
+	Triggers                    types.Map            `tfsdk:"triggers"`
+}
+
+// subscriptionDependencyLevels is the maximum depth of the dependency tree exposed by the subscription resource
+const subscriptionDependencyLevels = 3
+
+func subscriptionDependencyObjectType(level int) types.ObjectType {
+	attrTypes := map[string]attr.Type{
+		"app_name":  types.StringType,
+		"xsappname": types.StringType,
+		"error":     types.StringType,
+	}
+
+	if level > 1 {
+		attrTypes["dependencies"] = types.ListType{
+			ElemType: subscriptionDependencyObjectType(level - 1),
+		}
+	}
+
+	return types.ObjectType{
+		AttrTypes: attrTypes,
+	}
+}
+
+func subscriptionDependenciesValueFrom(ctx context.Context, dependencies []saas_manager_service.DependenciesResponseObject, level int) (types.List, diag.Diagnostics) {
+	var summary diag.Diagnostics
+
+	objectType := subscriptionDependencyObjectType(level)
+	elements := []attr.Value{}
+
+	for _, dependency := range dependencies {
+		attributes := map[string]attr.Value{
+			"app_name":  types.StringValue(dependency.AppName),
+			"xsappname": types.StringValue(dependency.Xsappname),
+			"error":     types.StringValue(dependency.Error_),
+		}
+
+		if level > 1 {
+			//Fetch the values of the nested dependencies for the particular level
+			nestedDependencies, diags := subscriptionDependenciesValueFrom(ctx, dependency.Dependencies, level-1)
+			summary.Append(diags...)
+			attributes["dependencies"] = nestedDependencies
+		}
+
+		element, diags := types.ObjectValue(objectType.AttrTypes, attributes)
+		summary.Append(diags...)
+
+		elements = append(elements, element)
+	}
+
+	dependenciesValue, diags := types.ListValue(objectType, elements)
+	summary.Append(diags...)
+
+	return dependenciesValue, summary
 }
 
 func subaccountSubscriptionValueFrom(ctx context.Context, value saas_manager_service.EntitledApplicationsResponseObject) (subaccountSubscriptionType, diag.Diagnostics) {
@@ -69,6 +130,7 @@ func subaccountSubscriptionValueFrom(ctx context.Context, value saas_manager_ser
 		SupportsParametersUpdates: types.BoolValue(value.SupportsParametersUpdates),
 		SupportsPlanUpdates:       types.BoolValue(value.SupportsPlanUpdates),
 		TenantId:                  types.StringValue(value.TenantId),
+		Dependencies:              types.ListNull(subscriptionDependencyObjectType(subscriptionDependencyLevels)),
 	}
 
 	var diags, diagnostics diag.Diagnostics
@@ -0,0 +1,14 @@
+# Move subaccounts to a directory
+action "btp_move_subaccount" "to_directory" {
+  config {
+    subaccount_ids      = ["6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f", "d7a7d7a8-8e3c-4c2f-9a6f-7f1b5f0c2e11"]
+    target_directory_id = "5357bda0-8651-4eab-a69d-12d282bc3247"
+  }
+}
+
+# Move a subaccount to the root of the global account
+action "btp_move_subaccount" "to_global_account" {
+  config {
+    subaccount_ids = ["6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"]
+  }
+}
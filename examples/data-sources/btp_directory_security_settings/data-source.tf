@@ -0,0 +1,3 @@
+data "btp_directory_security_settings" "directory" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+}
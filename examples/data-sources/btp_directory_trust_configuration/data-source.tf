@@ -0,0 +1,11 @@
+# default identity provider
+data "btp_directory_trust_configuration" "default" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  origin       = "sap.default"
+}
+
+# custom identity provider
+data "btp_directory_trust_configuration" "custom" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  origin       = "terraformint-platform"
+}
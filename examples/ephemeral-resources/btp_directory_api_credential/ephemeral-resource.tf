@@ -0,0 +1,13 @@
+# Create a short-lived API credential with a client secret for a directory
+ephemeral "btp_directory_api_credential" "with_secret" {
+  directory_id = "dd005d8b-1fee-4e6b-b6ff-cb9a197b7fe0"
+  name         = "directory-api-credential-with-secret"
+}
+
+# Create a short-lived read-only API credential with a certificate for a directory
+ephemeral "btp_directory_api_credential" "with_certificate" {
+  directory_id       = "dd005d8b-1fee-4e6b-b6ff-cb9a197b7fe0"
+  name               = "directory-api-credential-with-certificate"
+  certificate_passed = "-----BEGIN CERTIFICATE-----\nMIIDlzCCAn+gAwIBAgIUTdr....\n-----END CERTIFICATE-----\n"
+  read_only          = true
+}
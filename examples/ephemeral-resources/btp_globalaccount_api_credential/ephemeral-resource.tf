@@ -0,0 +1,11 @@
+# Create a short-lived API credential with a client secret for the global account
+ephemeral "btp_globalaccount_api_credential" "with_secret" {
+  name = "globalaccount-api-credential-with-secret"
+}
+
+# Create a short-lived read-only API credential with a certificate for the global account
+ephemeral "btp_globalaccount_api_credential" "with_certificate" {
+  name               = "globalaccount-api-credential-with-certificate"
+  certificate_passed = "-----BEGIN CERTIFICATE-----\nMIIDlzCCAn+gAwIBAgIUTdr....\n-----END CERTIFICATE-----\n"
+  read_only          = true
+}
@@ -0,0 +1,13 @@
+# Create a short-lived API credential with a client secret for a subaccount
+ephemeral "btp_subaccount_api_credential" "with_secret" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "subaccount-api-credential-with-secret"
+}
+
+# Create a short-lived read-only API credential with a certificate for a subaccount
+ephemeral "btp_subaccount_api_credential" "with_certificate" {
+  subaccount_id      = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name               = "subaccount-api-credential-with-certificate"
+  certificate_passed = "-----BEGIN CERTIFICATE-----\nMIIDlzCCAn+gAwIBAgIUTdr....\n-----END CERTIFICATE-----\n"
+  read_only          = true
+}
@@ -0,0 +1,16 @@
+# Read the credentials of a service binding by its ID
+ephemeral "btp_subaccount_service_binding_credentials" "by_id" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  id            = "b02e4b22-906b-40c5-9c5e-dbb6a9068444"
+}
+
+# Read the credentials of a service binding by its name
+ephemeral "btp_subaccount_service_binding_credentials" "by_name" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-binding"
+}
+
+# Pass the credentials on without storing them in the state
+locals {
+  binding_credentials = jsondecode(ephemeral.btp_subaccount_service_binding_credentials.by_name.credentials)
+}
@@ -0,0 +1,21 @@
+# Build the labels filter for all service instances of the production landscape that are owned by team a or team b
+# This will return the value "landscape eq 'production' and team in ('a','b')"
+data "btp_subaccount_service_instances" "production" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  labels_filter = provider::btp::build_labels_filter({
+    landscape = ["production"]
+    team      = ["a", "b"]
+  }, "service_manager")
+}
+
+# Single quotes in the values are escaped
+# This will return the value "owner eq 'O''Brien'"
+output "labels_filter" {
+  value = provider::btp::build_labels_filter({ owner = ["O'Brien"] }, "service_manager")
+}
+
+# Build the labels filter for all subaccounts that have a specific label attached
+# This will return the value "my-label=my-value"
+data "btp_subaccounts" "filtered" {
+  labels_filter = provider::btp::build_labels_filter({ my-label = ["my-value"] }, "cis")
+}
@@ -0,0 +1,5 @@
+# Read all values of the labels of a Cloud Foundry environment instance at once
+# This will return the value { cf_api_url = "https://api.cf.sap.hana.ondemand.com", cf_org_id = "...", cf_org_name = "...", kyma_api_server_url = null, kyma_kubeconfig_url = null }
+output "cf_labels" {
+  value = provider::btp::parse_labels(btp_subaccount_environment_instance.cloudfoundry.labels)
+}
@@ -0,0 +1,35 @@
+# This feature requires Terraform v1.14.0 or later (Stable as of 2026)
+# List resources must be defined in .tfquery.hcl files.
+
+# Generic template for a list block
+list "btp_globalaccount_role_collection_assignment" "<label_name>" {
+  # (Required) Provider instance to use
+  provider = provider_name
+
+  config {
+    # Provider specific filters
+  }
+}
+
+# List block to discover all users, groups and attributes assigned to a role collection of the global account
+# Returns only the resource identities by default.
+list "btp_globalaccount_role_collection_assignment" "all" {
+  provider = btp
+
+  # Required
+  config {
+    role_collection_name = "<role_collection_name>"
+  }
+}
+
+# List block to discover all users, groups and attributes assigned to a role collection of the global account with full resource details
+# Setting include_resource = true returns full resource objects
+list "btp_globalaccount_role_collection_assignment" "with_resource" {
+  provider         = btp
+  include_resource = true
+
+  # Required
+  config {
+    role_collection_name = "<role_collection_name>"
+  }
+}
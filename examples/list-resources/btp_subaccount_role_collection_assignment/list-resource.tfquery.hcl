@@ -0,0 +1,37 @@
+# This feature requires Terraform v1.14.0 or later (Stable as of 2026)
+# List resources must be defined in .tfquery.hcl files.
+
+# Generic template for a list block
+list "btp_subaccount_role_collection_assignment" "<label_name>" {
+  # (Required) Provider instance to use
+  provider = provider_name
+
+  config {
+    # Provider specific filters
+  }
+}
+
+# List block to discover all users, groups and attributes assigned to a role collection of a subaccount
+# Returns only the resource identities by default.
+list "btp_subaccount_role_collection_assignment" "all" {
+  provider = btp
+
+  # Required
+  config {
+    subaccount_id        = "<subaccount_id>"
+    role_collection_name = "<role_collection_name>"
+  }
+}
+
+# List block to discover all users, groups and attributes assigned to a role collection of a subaccount with full resource details
+# Setting include_resource = true returns full resource objects
+list "btp_subaccount_role_collection_assignment" "with_resource" {
+  provider         = btp
+  include_resource = true
+
+  # Required
+  config {
+    subaccount_id        = "<subaccount_id>"
+    role_collection_name = "<role_collection_name>"
+  }
+}
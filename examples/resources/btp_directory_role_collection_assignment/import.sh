@@ -0,0 +1,36 @@
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+# The identities of existing assignments can be discovered with the list resource btp_directory_role_collection_assignment.
+
+# Assignment of a user
+import {
+  to = btp_directory_role_collection_assignment.<resource_name>
+  identity = {
+    directory_id        = "<directory_id>"
+    role_collection_name = "<role_collection_name>"
+    user_name            = "<user_name>"
+    origin               = "<origin>"
+  }
+}
+
+# Assignment of a group
+import {
+  to = btp_directory_role_collection_assignment.<resource_name>
+  identity = {
+    directory_id        = "<directory_id>"
+    role_collection_name = "<role_collection_name>"
+    group_name           = "<group_name>"
+    origin               = "<origin>"
+  }
+}
+
+# Assignment of an attribute
+import {
+  to = btp_directory_role_collection_assignment.<resource_name>
+  identity = {
+    directory_id        = "<directory_id>"
+    role_collection_name = "<role_collection_name>"
+    attribute_name       = "<attribute_name>"
+    attribute_value      = "<attribute_value>"
+    origin               = "<origin>"
+  }
+}
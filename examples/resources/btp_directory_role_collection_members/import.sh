@@ -0,0 +1,20 @@
+# terraform import btp_directory_role_collection_members.<resource_name> '<directory_id>,<role_collection_name>'
+
+terraform import btp_directory_role_collection_members.directory_viewer '05368777-4934-41e8-9f3c-6ec5f4d564b9,Directory Viewer'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_directory_role_collection_members.<resource_name>
+  id = "<directory_id>,<role_collection_name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_directory_role_collection_members.<resource_name>
+  identity = {
+    directory_id         = "<directory_id>"
+    role_collection_name = "<role_collection_name>"
+  }
+}
@@ -0,0 +1,13 @@
+# manage all users, groups and attribute mappings of a role collection on directory level
+resource "btp_directory_role_collection_members" "directory_viewer" {
+  directory_id         = "05368777-4934-41e8-9f3c-6ec5f4d564b9"
+  role_collection_name = "Directory Viewer"
+
+  users = [
+    { user_name = "john.doe@mycompany.com", origin = "ldap" },
+  ]
+
+  groups = [
+    { group_name = "directory-viewer-group", origin = "my-custom-idp" },
+  ]
+}
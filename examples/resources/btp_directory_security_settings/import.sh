@@ -0,0 +1,19 @@
+# terraform import btp_directory_security_settings.<resource_name> '<directory_id>'
+
+terraform import btp_directory_security_settings.sec_setting 'f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_directory_security_settings.<resource_name>
+  id = "<directory_id>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_directory_security_settings.<resource_name>
+  identity = {
+    directory_id = "<directory_id>"
+  }
+}
@@ -0,0 +1,14 @@
+resource "btp_directory_security_settings" "sec_setting" {
+  directory_id = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+
+  default_identity_provider = "sap.custom"
+
+  access_token_validity  = 3600
+  refresh_token_validity = 3600
+
+  treat_users_with_same_email_as_same_user = true
+
+  custom_email_domains = ["yourdomain.test"]
+
+  iframe_domains_list = ["https://yourdomain.test"]
+}
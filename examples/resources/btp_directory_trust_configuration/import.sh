@@ -0,0 +1,20 @@
+# terraform import btp_directory_trust_configuration.<resource_name> '<directory_id>,<origin>'
+
+terraform import btp_directory_trust_configuration.trust 'f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d,sap.custom-platform'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_directory_trust_configuration.<resource_name>
+  id = "<directory_id>,<origin>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_directory_trust_configuration.<resource_name>
+  identity = {
+    directory_id = "<directory_id>"
+    origin       = "<origin>"
+  }
+}
@@ -0,0 +1,14 @@
+# create a new simple trust configuration for a directory
+resource "btp_directory_trust_configuration" "simple" {
+  directory_id      = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  identity_provider = "terraformint.accounts400.ondemand.com"
+}
+
+# create a new fully customized trust configuration for a directory
+resource "btp_directory_trust_configuration" "fully_customized" {
+  directory_id      = "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+  identity_provider = "terraformint.accounts400.ondemand.com"
+  name              = "my-name"
+  description       = "my-description"
+  origin            = "my-own-origin-platform"
+}
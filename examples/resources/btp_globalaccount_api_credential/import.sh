@@ -0,0 +1,21 @@
+# terraform import btp_globalaccount_api_credential.<resource_name> '<name>'
+
+terraform import btp_globalaccount_api_credential.my_credential 'my-api-credential'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_globalaccount_api_credential.<resource_name>
+  id = "<name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_globalaccount_api_credential.<resource_name>
+  identity = {
+    name = "<name>"
+  }
+}
+
+# The client secret, certificate and key are not returned by the API and remain empty after the import.
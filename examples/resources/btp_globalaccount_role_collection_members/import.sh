@@ -0,0 +1,19 @@
+# terraform import btp_globalaccount_role_collection_members.<resource_name> '<role_collection_name>'
+
+terraform import btp_globalaccount_role_collection_members.globalaccount_viewer 'Global Account Viewer'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_globalaccount_role_collection_members.<resource_name>
+  id = "<role_collection_name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_globalaccount_role_collection_members.<resource_name>
+  identity = {
+    role_collection_name = "<role_collection_name>"
+  }
+}
@@ -0,0 +1,12 @@
+# manage all users, groups and attribute mappings of a role collection on global account level
+resource "btp_globalaccount_role_collection_members" "globalaccount_viewer" {
+  role_collection_name = "Global Account Viewer"
+
+  users = [
+    { user_name = "john.doe@mycompany.com", origin = "ldap" },
+  ]
+
+  groups = [
+    { group_name = "globalaccount-viewer-group", origin = "my-custom-idp" },
+  ]
+}
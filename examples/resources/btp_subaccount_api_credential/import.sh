@@ -0,0 +1,22 @@
+# terraform import btp_subaccount_api_credential.<resource_name> '<subaccount_id>,<name>'
+
+terraform import btp_subaccount_api_credential.my_credential '6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,my-api-credential'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_subaccount_api_credential.<resource_name>
+  id = "<subaccount_id>,<name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_subaccount_api_credential.<resource_name>
+  identity = {
+    subaccount_id = "<subaccount_id>"
+    name          = "<name>"
+  }
+}
+
+# The client secret, certificate and key are not returned by the API and remain empty after the import.
@@ -0,0 +1,34 @@
+# creates a Cloud Foundry environment in a given subaccount
+resource "btp_subaccount_cloudfoundry_environment" "cloudfoundry" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-cf-environment"
+  instance_name = "my-cf-org-name"
+}
+
+# creates a Cloud Foundry environment with a memory quota on a specific landscape
+# ATTENTION: some regions offer multiple environments of a kind and you must explicitly select the target environment in which
+# the instance shall be created using the landscape label.
+# available environments can be looked up using the btp_subaccount_environments datasource
+resource "btp_subaccount_cloudfoundry_environment" "cloudfoundry_landscape" {
+  subaccount_id   = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name            = "my-cf-environment"
+  plan_name       = "standard"
+  landscape_label = "cf-eu10-002"
+  instance_name   = "my-cf-org-name"
+  memory          = 4096
+  timeouts = {
+    create = "25m"
+    update = "15m"
+    delete = "25m"
+  }
+}
+
+# the computed attributes can be used to configure the Cloud Foundry provider
+provider "cloudfoundry" {
+  api_url = btp_subaccount_cloudfoundry_environment.cloudfoundry.api_endpoint
+}
+
+resource "cloudfoundry_space" "dev" {
+  name = "dev"
+  org  = btp_subaccount_cloudfoundry_environment.cloudfoundry.org_id
+}
@@ -0,0 +1,41 @@
+# To import a destination certificate on the subaccount level, use the following syntax:
+# terraform import btp_subaccount_destination_certificate.<resource_name> '<subaccount_id>,<certificate_name>'
+terraform import btp_subaccount_destination_certificate.abc '6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,test.pem'
+
+# To import a destination certificate on the service instance level, use the following syntax:
+# terraform import btp_subaccount_destination_certificate.<resource_name> '<subaccount_id>,<certificate_name>,<service_instance_id>'
+terraform import btp_subaccount_destination_certificate.abc '6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,test.pem,6a55f158-41b5-4e63-aa77-84089fa0ab98'
+
+# terraform import using id attribute in import block
+# On Subaccount Level
+import {
+  to = btp_subaccount_destination_certificate.<resource_name>
+  id = "<subaccount_id>,<certificate_name>"
+}
+# On Service Instance Level
+import {
+  to = btp_subaccount_destination_certificate.<resource_name>
+  id = "<subaccount_id>,<certificate_name>,<service_instance_id>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+# On Subaccount Level
+import {
+  to = btp_subaccount_destination_certificate.<resource_name>
+  identity = {
+    certificate_name = "<certificate_name>"
+    subaccount_id    = "<subaccount_id>"
+  }
+}
+
+# On Service Instance Level
+import {
+  to = btp_subaccount_destination_certificate.<resource_name>
+  identity = {
+    certificate_name    = "<certificate_name>"
+    subaccount_id       = "<subaccount_id>"
+    service_instance_id = "<service_instance_id>"
+  }
+}
+
+# The certificate content is not returned by the API. It is taken over from the configuration with the first apply after the import.
@@ -0,0 +1,19 @@
+# Make sure that an active destination trust exists in a subaccount
+resource "btp_subaccount_destination_trust" "trust" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+}
+
+# Generate a passive destination trust and rotate the active trust by changing the rotation trigger, e.g. once a year
+resource "btp_subaccount_destination_trust" "rotated_trust" {
+  subaccount_id          = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  generate_passive_trust = true
+  rotation_trigger       = "2026"
+}
+
+# Alert on the upcoming expiration of the active trust
+check "destination_trust_expiration" {
+  assert {
+    condition     = timecmp(btp_subaccount_destination_trust.rotated_trust.active_trust.expiration, timeadd(plantimestamp(), "720h")) > 0
+    error_message = "The active destination trust expires within the next 30 days."
+  }
+}
@@ -0,0 +1,19 @@
+# terraform import btp_subaccount_entitlements.<resource_name> <subaccount_id>
+
+terraform import btp_subaccount_entitlements.all 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_subaccount_entitlements.<resource_name>
+  id = "<subaccount_id>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_subaccount_entitlements.<resource_name>
+  identity = {
+    subaccount_id = "<subaccount_id>"
+  }
+}
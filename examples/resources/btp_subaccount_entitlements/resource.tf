@@ -0,0 +1,21 @@
+# Manage all entitlements of a subaccount
+resource "btp_subaccount_entitlements" "all" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+  entitlements = [
+    {
+      service_name = "alert-notification"
+      plan_name    = "free"
+    },
+    {
+      service_name = "hana-cloud"
+      plan_name    = "hana"
+      amount       = 2
+    },
+    {
+      service_name = "destination"
+      plan_name    = "lite"
+      amount       = 1
+    }
+  ]
+}
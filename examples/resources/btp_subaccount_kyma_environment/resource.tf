@@ -0,0 +1,48 @@
+# creates a Kyma environment on AWS with the default modules
+resource "btp_subaccount_kyma_environment" "kyma" {
+  subaccount_id  = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name           = "my-kyma-cluster"
+  plan_name      = "aws"
+  region         = "eu-central-1"
+  machine_type   = "m6i.large"
+  autoscaler_min = 3
+  autoscaler_max = 10
+  administrators = ["jenny.doe@test.com"]
+}
+
+# creates a Kyma environment on Azure with a custom OIDC configuration and a selection of modules
+# in addition add a custom timeout for the create and delete operation
+resource "btp_subaccount_kyma_environment" "kyma_custom" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-custom-kyma-cluster"
+  plan_name     = "azure"
+  region        = "westeurope"
+  oidc = {
+    client_id      = "9f1c3a5e-2b7d-4c8e-a6f0-1d2e3f4a5b6c"
+    issuer_url     = "https://my-tenant.accounts.ondemand.com"
+    groups_claim   = "groups"
+    username_claim = "sub"
+    signing_algs   = ["RS256"]
+  }
+  modules = [
+    {
+      name    = "api-gateway"
+      channel = "regular"
+    },
+    {
+      name                   = "serverless"
+      channel                = "fast"
+      custom_resource_policy = "CreateAndDelete"
+    }
+  ]
+  timeouts = {
+    create = "1h"
+    update = "35m"
+    delete = "1h"
+  }
+}
+
+# the kubeconfig URL can be used directly, e.g. with the provider function download_kyma_kubeconfig
+output "kubeconfig_url" {
+  value = btp_subaccount_kyma_environment.kyma.kubeconfig_url
+}
@@ -0,0 +1,20 @@
+# terraform import btp_subaccount_role_collection_members.<resource_name> '<subaccount_id>,<role_collection_name>'
+
+terraform import btp_subaccount_role_collection_members.subaccount_viewer '6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,Subaccount Viewer'
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_subaccount_role_collection_members.<resource_name>
+  id = "<subaccount_id>,<role_collection_name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = btp_subaccount_role_collection_members.<resource_name>
+  identity = {
+    subaccount_id        = "<subaccount_id>"
+    role_collection_name = "<role_collection_name>"
+  }
+}
@@ -0,0 +1,24 @@
+# manage all users, groups and attribute mappings of a role collection on subaccount level
+resource "btp_subaccount_role_collection_members" "subaccount_viewer" {
+  subaccount_id        = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  role_collection_name = "Subaccount Viewer"
+
+  users = [
+    { user_name = "john.doe@mycompany.com", origin = "ldap" },
+    { user_name = "jane.doe@mycompany.com", origin = "ldap" },
+  ]
+
+  groups = [
+    { group_name = "subaccount-viewer-group", origin = "my-custom-idp" },
+  ]
+
+  attributes = [
+    { attribute_name = "Department", attribute_value = "IT", origin = "my-custom-idp" },
+  ]
+}
+
+# remove all assignments from a role collection on subaccount level
+resource "btp_subaccount_role_collection_members" "subaccount_administrator" {
+  subaccount_id        = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  role_collection_name = "Subaccount Administrator"
+}
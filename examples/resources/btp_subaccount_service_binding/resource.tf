@@ -15,3 +15,15 @@ resource "btp_subaccount_service_binding" "my_parameterized_binding" {
     param_b = ""
   })
 }
+
+# create a service binding in a subaccount whose credentials are rotated every 30 days
+# the previous binding is kept for one more day after each rotation
+resource "btp_subaccount_service_binding" "my_rotated_binding" {
+  subaccount_id       = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  service_instance_id = "8911491d-0e1d-425d-a233-785512602d6f"
+  name                = "my-rotated-binding"
+  rotation = {
+    frequency = "720h"
+    overlap   = "24h"
+  }
+}
@@ -0,0 +1,18 @@
+# terraform import btp_subaccount_service_platform.<resource_name> <subaccount_id>,<service_platform_id>
+
+terraform import btp_subaccount_service_platform.my_cluster 6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f,76765dca-6683-473a-8f42-809e33a2ea68
+
+# terraform import using id attribute in import block
+
+import {
+  to = btp_subaccount_service_platform.<resource_name>
+  id = "<subaccount_id>,<service_platform_id>"
+}
+
+import {
+  to = btp_subaccount_service_platform.<resource_name>
+  identity = {
+    subaccount_id = "<subaccount_id>"
+    id            = "<service_platform_id>"
+  }
+}
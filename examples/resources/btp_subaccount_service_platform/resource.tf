@@ -0,0 +1,12 @@
+# Register a Kubernetes cluster as platform to consume services via the SAP BTP service operator
+resource "btp_subaccount_service_platform" "my_cluster" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  name          = "my-cluster"
+  type          = "kubernetes"
+  description   = "Kubernetes cluster of the development landscape."
+}
+
+output "service_operator_client_id" {
+  value     = btp_subaccount_service_platform.my_cluster.credentials.username
+  sensitive = true
+}
@@ -16,3 +16,19 @@ resource "btp_subaccount_subscription" "workzone" {
     delete = "15m"
   }
 }
+
+
+# create a subscription to a custom multitenant application and update its dependencies
+resource "btp_subaccount_subscription" "custom_app" {
+  subaccount_id = "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+  app_name      = "my-multitenant-app"
+  plan_name     = "default"
+  dependency_update = {
+    app_provider_custom_properties = jsonencode({
+      "tenantType" = "productive"
+    })
+    triggers = {
+      release = "2026.10"
+    }
+  }
+}
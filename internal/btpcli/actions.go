@@ -21,6 +21,7 @@ const (
 	ActionUnsubscribe Action = "unsubscribe"
 	ActionUpdate      Action = "update"
 	ActionRestore     Action = "restore"
+	ActionMove        Action = "move"
 )
 
 // NewAddRequest creates a new add request
@@ -112,3 +113,8 @@ func NewUpdateRequest(command string, args any) *CommandRequest {
 func NewRestoreRequest(command string, args any) *CommandRequest {
 	return NewCommandRequest(ActionRestore, command, args)
 }
+
+// NewMoveRequest creates a new move request
+func NewMoveRequest(command string, args any) *CommandRequest {
+	return NewCommandRequest(ActionMove, command, args)
+}
@@ -13,11 +13,14 @@ import (
 	"os/exec"
 	"path"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpclisession"
+	"github.com/SAP/terraform-provider-btp/internal/sessioncache"
 	"github.com/hashicorp/go-retryablehttp"
 	uuid "github.com/hashicorp/go-uuid"
 )
@@ -75,6 +78,35 @@ type RetryConfig struct {
 	RetryMax     int
 	RetryWaitMin time.Duration
 	RetryWaitMax time.Duration
+	// RetryableStatusCodes are HTTP status codes that are retried in addition to the default ones.
+	RetryableStatusCodes []int
+	// RetryableErrorCodes are BTP error codes in the format "<code>/<status>" (e.g. "30004/400")
+	// that are retried in addition to the default ones.
+	RetryableErrorCodes []string
+}
+
+// DefaultRetryConfig returns the retry configuration that is used if no explicit configuration is provided.
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		Enabled:      true,
+		RetryMax:     6,
+		RetryWaitMin: 1 * time.Second,
+		RetryWaitMax: 120 * time.Second,
+	}
+}
+
+// defaultRetryableStatusCodes are the HTTP status codes that are always retried
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,     // 429
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusServiceUnavailable,  // 503
+	http.StatusGatewayTimeout,      // 504
+}
+
+// defaultRetryableErrorCodes are the BTP error codes that are always retried
+var defaultRetryableErrorCodes = []string{
+	"30004/400", // for locking scenario API call must be retried
 }
 
 func NewV2Client(serverURL *url.URL) *v2Client {
@@ -84,12 +116,7 @@ func NewV2Client(serverURL *url.URL) *v2Client {
 func NewRetryableHttpClient(cfg *RetryConfig) *retryablehttp.Client {
 	retryClient := retryablehttp.NewClient()
 	if cfg == nil {
-		cfg = &RetryConfig{
-			Enabled:      true,
-			RetryMax:     6,
-			RetryWaitMin: 1 * time.Second,
-			RetryWaitMax: 120 * time.Second,
-		}
+		cfg = DefaultRetryConfig()
 	}
 	retryClient.RetryMax = cfg.RetryMax
 	retryClient.RetryWaitMin = cfg.RetryWaitMin
@@ -107,8 +134,11 @@ func NewRetryableHttpClient(cfg *RetryConfig) *retryablehttp.Client {
 		return retryClient
 	}
 
+	retryableStatusCodes := append(append([]int{}, defaultRetryableStatusCodes...), cfg.RetryableStatusCodes...)
+	retryableErrorCodes := append(append([]string{}, defaultRetryableErrorCodes...), cfg.RetryableErrorCodes...)
+
 	retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		// Retry on transient network errors and specific HTTP status codes (429, 500, 502, 503)
+		// Retry on transient network errors, the retryable HTTP status codes and the retryable BTP error codes
 		if err != nil {
 			return true, nil
 		}
@@ -116,42 +146,69 @@ func NewRetryableHttpClient(cfg *RetryConfig) *retryablehttp.Client {
 			return false, nil
 		}
 
-		switch resp.StatusCode {
-		case http.StatusTooManyRequests, // 429
-			http.StatusInternalServerError, // 500
-			http.StatusBadGateway,          // 502
-			http.StatusServiceUnavailable,  // 503
-			http.StatusGatewayTimeout:      // 504
-			// retry only these
+		if slices.Contains(retryableStatusCodes, resp.StatusCode) {
 			return true, nil
+		}
 
-		case http.StatusBadRequest: // 400
-			// Peek into the body to check for specific error codes/messages
-			const maxBodyPeek = 4096
-			var buf bytes.Buffer
-			tee := io.TeeReader(io.LimitReader(resp.Body, maxBodyPeek), &buf)
-			peekBytes, _ := io.ReadAll(tee)
-
-			resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(buf.Bytes()), resp.Body))
-
-			if strings.Contains(string(peekBytes), "[Error: 30004/400]") {
-				return true, nil // for locking scenario API call must be retried
-			}
-			return false, nil
-		default:
-			// do not retry on 4xx client errors, or other 5xx errors
+		errorCodesForStatus := errorCodesMatchingStatus(retryableErrorCodes, resp.StatusCode)
+		if len(errorCodesForStatus) == 0 {
+			// do not retry on other 4xx client errors, or other 5xx errors
 			return false, nil
 		}
+
+		// Peek into the body to check for specific error codes/messages
+		const maxBodyPeek = 4096
+		var buf bytes.Buffer
+		tee := io.TeeReader(io.LimitReader(resp.Body, maxBodyPeek), &buf)
+		peekBytes, _ := io.ReadAll(tee)
+
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(buf.Bytes()), resp.Body))
+
+		return bodyContainsErrorCode(peekBytes, errorCodesForStatus), nil
 	}
 	return retryClient
 }
 
+// errorCodesMatchingStatus returns the BTP error codes ("<code>/<status>") that belong to the given HTTP status code
+func errorCodesMatchingStatus(errorCodes []string, statusCode int) []string {
+	var matching []string
+	suffix := "/" + strconv.Itoa(statusCode)
+
+	for _, errorCode := range errorCodes {
+		if strings.HasSuffix(errorCode, suffix) {
+			matching = append(matching, errorCode)
+		}
+	}
+
+	return matching
+}
+
+// bodyContainsErrorCode checks if the response body reports one of the given BTP error codes, either in the
+// textual format "[Error: <code>/<status>]" or as code in a JSON error response body
+func bodyContainsErrorCode(body []byte, errorCodes []string) bool {
+	var errorBody ErrorResponseBody
+	_ = json.Unmarshal(body, &errorBody)
+
+	for _, errorCode := range errorCodes {
+		if strings.Contains(string(body), fmt.Sprintf("[Error: %s]", errorCode)) {
+			return true
+		}
+
+		if errorBody.Error != nil && strings.HasPrefix(errorCode, strconv.Itoa(errorBody.Error.Code)+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewV2ClientWithHttpClient(client *http.Client, serverURL *url.URL, retryCfg *RetryConfig) *v2Client {
 	retryClient := NewRetryableHttpClient(retryCfg)
-	retryClient.HTTPClient = client
+	retryClient.HTTPClient = injectRateLimitTransport(client, nil)
 	return &v2Client{
 		httpClient: injectBTPCLITransport(retryClient.StandardClient()),
 		serverURL:  serverURL,
+		rateLimit:  retryClient.HTTPClient.Transport.(*rateLimitTransport),
 		newCorrelationID: func() string {
 			val, err := uuid.GenerateUUID()
 			if err != nil {
@@ -188,6 +245,18 @@ type v2Client struct {
 
 	session   *Session
 	UserAgent string
+
+	// renewSession repeats the login flow of the current session. It is only set for
+	// login flows that can be repeated without user interaction.
+	renewSession      func(ctx context.Context) error
+	renewSessionMutex sync.Mutex
+
+	// sessionCache persists sessions across provider invocations. The key is only set if the
+	// current session is cached.
+	sessionCache    *sessioncache.Cache
+	sessionCacheKey *sessioncache.Key
+
+	rateLimit *rateLimitTransport
 }
 
 func (v2 *v2Client) initTrace(ctx context.Context) context.Context {
@@ -310,6 +379,27 @@ func (v2 *v2Client) parseResponseError(res *http.Response) error {
 
 // Login authenticates a user using username + password
 func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginResponse, error) {
+	user := loginReq.Username
+	if len(user) == 0 && loginReq.Jwt != nil {
+		user = jwtSubject(*loginReq.Jwt)
+	}
+
+	loginResponse, err := v2.cachedLogin(ctx, loginReq.GlobalAccountSubdomain, loginReq.IdentityProvider, user, loginReq.Password, func(ctx context.Context) (*LoginResponse, error) {
+		return v2.login(ctx, loginReq)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v2.setRenewSession(func(ctx context.Context) error {
+		_, err := v2.login(ctx, loginReq)
+		return err
+	})
+
+	return loginResponse, nil
+}
+
+func (v2 *v2Client) login(ctx context.Context, loginReq *LoginRequest) (*LoginResponse, error) {
 	ctx = v2.initTrace(ctx)
 
 	// TODO: After the switch to client protocol v2.49.0 the terraform provider is still providing
@@ -334,7 +424,7 @@ func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginRe
 		return nil, err
 	}
 
-	v2.session = &Session{
+	v2.setSession(&Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginReq.IdentityProvider,
 		LoggedInUser: &v2LoggedInUser{
@@ -342,13 +432,30 @@ func (v2 *v2Client) Login(ctx context.Context, loginReq *LoginRequest) (*LoginRe
 			Issuer: loginResponse.Issuer,
 		},
 		SessionId: res.Header.Get(HeaderCLISessionId),
-	}
+	})
 
 	return &loginResponse, nil
 }
 
 // IdTokenLogin authenticates a user by providing an id token
 func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequest) (*LoginResponse, error) {
+	// A new id token is issued for every run, so the cached session is identified by the issuer and subject of the token only
+	loginResponse, err := v2.cachedLogin(ctx, loginReq.GlobalAccountSubdomain, "", jwtSubject(loginReq.IdToken), "", func(ctx context.Context) (*LoginResponse, error) {
+		return v2.idTokenLogin(ctx, loginReq)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v2.setRenewSession(func(ctx context.Context) error {
+		_, err := v2.idTokenLogin(ctx, loginReq)
+		return err
+	})
+
+	return loginResponse, nil
+}
+
+func (v2 *v2Client) idTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequest) (*LoginResponse, error) {
 	ctx = v2.initTrace(ctx)
 
 	res, err := v2.doPostRequest(ctx, path.Join("login", cliTargetProtocolVersion, "idtoken"), loginReq)
@@ -370,7 +477,7 @@ func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequ
 		return nil, err
 	}
 
-	v2.session = &Session{
+	v2.setSession(&Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginResponse.Issuer,
 		LoggedInUser: &v2LoggedInUser{
@@ -378,7 +485,7 @@ func (v2 *v2Client) IdTokenLogin(ctx context.Context, loginReq *IdTokenLoginRequ
 			Issuer: loginResponse.Issuer,
 		},
 		SessionId: res.Header.Get(HeaderCLISessionId),
-	}
+	})
 
 	return &loginResponse, nil
 }
@@ -441,7 +548,7 @@ func (v2 *v2Client) BrowserLogin(ctx context.Context, loginReq *BrowserLoginRequ
 		return nil, err
 	}
 
-	v2.session = &Session{
+	v2.setSession(&Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginReq.CustomIdp,
 		LoggedInUser: &v2LoggedInUser{
@@ -449,7 +556,7 @@ func (v2 *v2Client) BrowserLogin(ctx context.Context, loginReq *BrowserLoginRequ
 			Issuer: browserLoginPostResponse.Issuer,
 		},
 		SessionId: res.Header.Get(HeaderCLISessionId),
-	}
+	})
 
 	return &browserLoginPostResponse, nil
 }
@@ -490,6 +597,23 @@ func OptionalCustomIdpPath(loginReq *BrowserLoginRequest) string {
 
 // PasscodeLogin authenticates with a pem encoded x509 key-pair
 func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRequest) (*LoginResponse, error) {
+	loginResponse, err := v2.cachedLogin(ctx, loginReq.GlobalAccountSubdomain, loginReq.IdentityProvider, loginReq.Username, loginReq.PEMEncodedCertificate+loginReq.PEMEncodedPrivateKey, func(ctx context.Context) (*LoginResponse, error) {
+		return v2.passcodeLogin(ctx, loginReq)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the passcode can only be used once, so a new one has to be requested to renew the session
+	v2.setRenewSession(func(ctx context.Context) error {
+		_, err := v2.passcodeLogin(ctx, loginReq)
+		return err
+	})
+
+	return loginResponse, nil
+}
+
+func (v2 *v2Client) passcodeLogin(ctx context.Context, loginReq *PasscodeLoginRequest) (*LoginResponse, error) {
 	ctx = v2.initTrace(ctx)
 
 	clientCert, err := tls.X509KeyPair([]byte(loginReq.PEMEncodedCertificate), []byte(loginReq.PEMEncodedPrivateKey))
@@ -534,7 +658,7 @@ func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRe
 		return nil, err
 	}
 
-	return v2.Login(ctx, &LoginRequest{
+	return v2.login(ctx, &LoginRequest{
 		IdentityProvider:       loginReq.IdentityProvider,
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		Username:               loginReq.Username,
@@ -542,7 +666,23 @@ func (v2 *v2Client) PasscodeLogin(ctx context.Context, loginReq *PasscodeLoginRe
 	})
 }
 
+// BtpCliSessionLogin reuses the session of a btp CLI that is already logged in
 func (v2 *v2Client) BtpCliSessionLogin(ctx context.Context, loginReq *BtpCliSessionLoginRequest) (*LoginResponse, error) {
+	loginResponse, err := v2.btpCliSessionLogin(loginReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// the btp CLI might have refreshed its session in the meantime, so the session information is read again
+	v2.setRenewSession(func(ctx context.Context) error {
+		_, err := v2.btpCliSessionLogin(loginReq)
+		return err
+	})
+
+	return loginResponse, nil
+}
+
+func (v2 *v2Client) btpCliSessionLogin(loginReq *BtpCliSessionLoginRequest) (*LoginResponse, error) {
 	res, err := btpclisession.ReadSession(loginReq.CliConfigPath)
 	if err != nil {
 		return nil, fmt.Errorf("read BTP CLI session information: %w", err)
@@ -552,7 +692,7 @@ func (v2 *v2Client) BtpCliSessionLogin(ctx context.Context, loginReq *BtpCliSess
 		return nil, fmt.Errorf("no active BTP CLI session found (source: %s); please run 'btp login' first", res.Source)
 	}
 
-	v2.session = &Session{
+	v2.setSession(&Session{
 		GlobalAccountSubdomain: loginReq.GlobalAccountSubdomain,
 		IdentityProvider:       loginReq.IdentityProvider,
 		LoggedInUser: &v2LoggedInUser{
@@ -560,13 +700,74 @@ func (v2 *v2Client) BtpCliSessionLogin(ctx context.Context, loginReq *BtpCliSess
 			Issuer: res.Config.Authentication.Issuer,
 		},
 		SessionId: res.SessionID,
-	}
+	})
 
 	return &LoginResponse{
 		Email:  res.Config.Authentication.Mail,
 		Issuer: res.Config.Authentication.Issuer,
 	}, nil
+}
 
+// setRenewSession stores the function that is used to renew an expired session
+func (v2 *v2Client) setRenewSession(renewSession func(ctx context.Context) error) {
+	v2.renewSessionMutex.Lock()
+	defer v2.renewSessionMutex.Unlock()
+
+	v2.renewSession = renewSession
+}
+
+// renewExpiredSession repeats the login flow if the session with the given id has expired. If the session
+// has already been renewed in the meantime, e.g. by a parallel request, the login flow is not repeated.
+// It reports whether the failed request can be replayed with a renewed session.
+func (v2 *v2Client) renewExpiredSession(ctx context.Context, expiredSessionId string) (bool, error) {
+	v2.renewSessionMutex.Lock()
+	defer v2.renewSessionMutex.Unlock()
+
+	if v2.getSessionId() != expiredSessionId {
+		return v2.renewSession != nil, nil
+	}
+
+	v2.deleteCachedSession()
+
+	if v2.renewSession == nil {
+		return false, nil
+	}
+
+	if err := v2.renewSession(ctx); err != nil {
+		return false, fmt.Errorf("the session has expired and could not be renewed: %w", err)
+	}
+
+	v2.storeCachedSession()
+
+	return true, nil
+}
+
+// setSession stores the session of a successful login. An existing session is updated in place, as it
+// might be in use by parallel requests.
+func (v2 *v2Client) setSession(session *Session) {
+	if v2.session == nil {
+		v2.session = session
+		return
+	}
+
+	v2.session.Lock()
+	defer v2.session.Unlock()
+
+	v2.session.GlobalAccountSubdomain = session.GlobalAccountSubdomain
+	v2.session.SessionId = session.SessionId
+	v2.session.IdentityProvider = session.IdentityProvider
+	v2.session.LoggedInUser = session.LoggedInUser
+}
+
+func (v2 *v2Client) getSessionId() string {
+	if v2.session == nil {
+		return ""
+	}
+
+	v2.session.Lock()
+	defer v2.session.Unlock()
+
+	return v2.session.SessionId
 }
 
 // Execute executes a command
@@ -579,12 +780,32 @@ func (v2 *v2Client) Execute(ctx context.Context, cmdReq *CommandRequest, options
 		ParamValues: cmdReq.Args,
 	}
 
-	res, err := v2.doPostRequest(ctx, fmt.Sprintf("%s?%s", path.Join("command", cliTargetProtocolVersion, cmdReq.Command), cmdReq.Action), wrappedArgs)
+	endpoint := fmt.Sprintf("%s?%s", path.Join("command", cliTargetProtocolVersion, cmdReq.Command), cmdReq.Action)
+	sessionId := v2.getSessionId()
+
+	res, err := v2.doPostRequest(ctx, endpoint, wrappedArgs)
 
 	if err != nil {
 		return
 	}
 
+	if res.StatusCode == http.StatusUnauthorized {
+		// the session has expired or became invalid, so the login is repeated once and the command replayed
+		var renewed bool
+		if renewed, err = v2.renewExpiredSession(ctx, sessionId); err != nil {
+			_ = res.Body.Close()
+			return
+		}
+
+		if renewed {
+			_ = res.Body.Close()
+
+			if res, err = v2.doPostRequest(ctx, endpoint, wrappedArgs); err != nil {
+				return
+			}
+		}
+	}
+
 	opts := firstElementOrDefault(options, CommandOptions{GoodState: http.StatusOK, KnownErrorStates: map[int]string{}})
 	opts.KnownErrorStates[http.StatusGatewayTimeout] = "Command timed out. Please try again later."
 	opts.KnownErrorStates[http.StatusForbidden] = "Access forbidden due to insufficient authorization. Make sure to have sufficient access rights."
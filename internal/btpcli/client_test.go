@@ -528,6 +528,135 @@ func TestV2Client_Execute(t *testing.T) {
 	})
 }
 
+func TestV2Client_SessionRenewal(t *testing.T) {
+	t.Parallel()
+
+	// newSessionServer simulates a CLI server whose sessions expire after the given number of commands
+	newSessionServer := func(loginCount *atomic.Int32, commandsPerSession int32, loginStatus int) *httptest.Server {
+		var commandCount atomic.Int32
+
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/login") {
+				if loginStatus != http.StatusOK {
+					w.WriteHeader(loginStatus)
+					return
+				}
+
+				commandCount.Store(0)
+				w.Header().Set(HeaderCLISessionId, fmt.Sprintf("session-%d", loginCount.Add(1)))
+				_, _ = fmt.Fprintf(w, `{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+				return
+			}
+
+			if r.Header.Get(HeaderCLISessionId) != fmt.Sprintf("session-%d", loginCount.Load()) || commandCount.Add(1) > commandsPerSession {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			w.Header().Set(HeaderCLIBackendStatus, "200")
+			_, _ = fmt.Fprintf(w, "{}")
+		}))
+	}
+
+	newClient := func(srv *httptest.Server) *v2Client {
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl, &RetryConfig{Enabled: false})
+		uut.newCorrelationID = func() string { return "test-cid" }
+		return uut
+	}
+
+	t.Run("expired session is renewed and the command replayed", func(t *testing.T) {
+		var loginCount atomic.Int32
+		srv := newSessionServer(&loginCount, 1, http.StatusOK)
+		defer srv.Close()
+
+		uut := newClient(srv)
+
+		_, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		_, err = uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.NoError(t, err)
+
+		res, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, int32(2), loginCount.Load())
+		assert.Equal(t, "session-2", uut.session.SessionId)
+	})
+
+	t.Run("session is renewed only once for parallel commands", func(t *testing.T) {
+		var loginCount atomic.Int32
+		srv := newSessionServer(&loginCount, 1000, http.StatusOK)
+		defer srv.Close()
+
+		uut := newClient(srv)
+
+		_, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		// simulate that the session has expired on the server
+		uut.session.SessionId = "expired"
+
+		done := make(chan error)
+		for range 5 {
+			go func() {
+				_, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+				done <- err
+			}()
+		}
+
+		for range 5 {
+			assert.NoError(t, <-done)
+		}
+		assert.Equal(t, int32(2), loginCount.Load())
+	})
+
+	t.Run("command fails if the session cannot be renewed", func(t *testing.T) {
+		var loginCount atomic.Int32
+		srv := newSessionServer(&loginCount, 0, http.StatusOK)
+		defer srv.Close()
+
+		uut := newClient(srv)
+
+		_, err := uut.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		assert.NoError(t, err)
+
+		_, err = uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.EqualError(t, err, "received response with unexpected status: 401 [Status: 401; Correlation ID: test-cid]")
+		assert.Equal(t, int32(2), loginCount.Load())
+	})
+
+	t.Run("command fails if the login fails", func(t *testing.T) {
+		var loginCount atomic.Int32
+		srv := newSessionServer(&loginCount, 0, http.StatusUnauthorized)
+		defer srv.Close()
+
+		uut := newClient(srv)
+		uut.session = &Session{SessionId: "expired"}
+		uut.renewSession = func(ctx context.Context) error {
+			_, err := uut.login(ctx, NewLoginRequest("subdomain", "john.doe", "pass"))
+			return err
+		}
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.EqualError(t, err, "the session has expired and could not be renewed: Login failed. Check your credentials. [Status: 401; Correlation ID: test-cid]")
+	})
+
+	t.Run("session without repeatable login flow is not renewed", func(t *testing.T) {
+		var loginCount atomic.Int32
+		srv := newSessionServer(&loginCount, 0, http.StatusOK)
+		defer srv.Close()
+
+		uut := newClient(srv)
+		uut.session = &Session{SessionId: "expired"}
+
+		_, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.EqualError(t, err, "received response with unexpected status: 401 [Status: 401; Correlation ID: test-cid]")
+		assert.Equal(t, int32(0), loginCount.Load())
+	})
+}
+
 type v2SimulationConfig struct {
 	// initialize the client session prior to the test simulation
 	initSession *Session
@@ -741,3 +870,109 @@ func TestV2Client_RetryLogic(t *testing.T) {
 	assert.GreaterOrEqual(t, elapsedSec, 1.0, "should wait at least 1s due to Retry-After header")
 	assert.Less(t, elapsedSec, 5.0, "should not take more than 5s even with retries")
 }
+
+func TestV2Client_RetryConfig(t *testing.T) {
+	t.Parallel()
+
+	newTestServer := func(attemptCount *atomic.Int32, failures int32, status int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attemptCount.Add(1) <= failures {
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(body))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`))
+		}))
+	}
+
+	login := func(server *httptest.Server, cfg *RetryConfig) error {
+		serverUrl, _ := url.Parse(server.URL)
+		testClient := NewV2ClientWithHttpClient(server.Client(), serverUrl, cfg)
+		testClient.newCorrelationID = func() string { return "test-cid" }
+
+		_, err := testClient.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+		return err
+	}
+
+	fastRetries := func() *RetryConfig {
+		return &RetryConfig{
+			Enabled:      true,
+			RetryMax:     3,
+			RetryWaitMin: 1 * time.Millisecond,
+			RetryWaitMax: 5 * time.Millisecond,
+		}
+	}
+
+	t.Run("default error code is retried", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 2, http.StatusBadRequest, `[Error: 30004/400]`)
+		defer server.Close()
+
+		assert.NoError(t, login(server, fastRetries()))
+		assert.Equal(t, int32(3), attemptCount.Load())
+	})
+
+	t.Run("unknown status code is not retried", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 1, http.StatusConflict, `conflict`)
+		defer server.Close()
+
+		assert.Error(t, login(server, fastRetries()))
+		assert.Equal(t, int32(1), attemptCount.Load())
+	})
+
+	t.Run("additional status code is retried", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 2, http.StatusConflict, `conflict`)
+		defer server.Close()
+
+		cfg := fastRetries()
+		cfg.RetryableStatusCodes = []int{http.StatusConflict}
+
+		assert.NoError(t, login(server, cfg))
+		assert.Equal(t, int32(3), attemptCount.Load())
+	})
+
+	t.Run("additional error code in JSON body is retried", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 1, http.StatusConflict, `{"error":{"code":11111,"message":"busy"}}`)
+		defer server.Close()
+
+		cfg := fastRetries()
+		cfg.RetryableErrorCodes = []string{"11111/409"}
+
+		assert.NoError(t, login(server, cfg))
+		assert.Equal(t, int32(2), attemptCount.Load())
+	})
+
+	t.Run("error code with different status is not retried", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 1, http.StatusConflict, `[Error: 30004/400]`)
+		defer server.Close()
+
+		assert.Error(t, login(server, fastRetries()))
+		assert.Equal(t, int32(1), attemptCount.Load())
+	})
+
+	t.Run("maximum number of retries is respected", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 10, http.StatusServiceUnavailable, `unavailable`)
+		defer server.Close()
+
+		assert.Error(t, login(server, fastRetries()))
+		assert.Equal(t, int32(4), attemptCount.Load())
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		var attemptCount atomic.Int32
+		server := newTestServer(&attemptCount, 2, http.StatusServiceUnavailable, `unavailable`)
+		defer server.Close()
+
+		cfg := fastRetries()
+		cfg.Enabled = false
+
+		assert.Error(t, login(server, cfg))
+		assert.Equal(t, int32(1), attemptCount.Load())
+	})
+}
@@ -72,6 +72,20 @@ type PasscodeLoginRequest struct {
 	PEMEncodedCertificate  string
 }
 
+// OIDCLoginRequest describes where the OIDC token of a workload identity is taken from and how it is
+// exchanged before it is used as assertion for the login. If neither TokenFile nor TokenEnvVar is set,
+// the token is requested from the GitHub Actions token endpoint.
+type OIDCLoginRequest struct {
+	GlobalAccountSubdomain string
+	IdentityProvider       string
+	TokenFile              string
+	TokenEnvVar            string
+	Audience               string
+	TokenExchangeURL       string
+	ClientID               string
+	ClientSecret           string
+}
+
 type BrowserLoginRequest struct {
 	CustomIdp              string `json:"customIdp"`
 	GlobalAccountSubdomain string `json:"subdomain"`
@@ -2,6 +2,7 @@ package btpcli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -138,6 +139,42 @@ func (f *accountsEntitlementFacade) DisableInSubaccount(ctx context.Context, dir
 	return res, err
 }
 
+// SubaccountServicePlanAssignment is the assignment of a service plan to one or more subaccounts. In contrast to
+// cis_entitlements.ServicePlanAssignmentRequestPayload, an amount of 0 and a disabled plan are part of the payload,
+// so that assignments can be removed.
+type SubaccountServicePlanAssignment struct {
+	AssignmentInfo  []SubaccountServicePlanAssignmentInfo `json:"assignmentInfo"`
+	ServiceName     string                                `json:"serviceName"`
+	ServicePlanName string                                `json:"servicePlanName"`
+}
+
+type SubaccountServicePlanAssignmentInfo struct {
+	Amount         *float64 `json:"amount,omitempty"`
+	Enable         *bool    `json:"enable,omitempty"`
+	SubaccountGUID string   `json:"subaccountGUID"`
+}
+
+// AssignToSubaccounts assigns or removes several service plans of one or more subaccounts in a single call. Plans with
+// a numeric quota are assigned with the given amount, plans without a numeric quota are enabled or disabled.
+func (f *accountsEntitlementFacade) AssignToSubaccounts(ctx context.Context, directoryId string, assignments []SubaccountServicePlanAssignment) (CommandResponse, error) {
+	jsonBytes, err := json.Marshal(assignments)
+	if err != nil {
+		return CommandResponse{}, err
+	}
+
+	params := map[string]string{
+		"globalAccount":          f.cliClient.GetGlobalAccountSubdomain(),
+		"subaccountServicePlans": string(jsonBytes),
+	}
+
+	if len(directoryId) > 0 {
+		params["directoryID"] = directoryId
+	}
+
+	_, res, err := doExecute[cis_entitlements.EntitlementAssignmentResponseObject](f.cliClient, ctx, NewAssignRequest(f.getCommand(), params))
+	return res, err
+}
+
 type UnfoldedAssignment struct {
 	Service    cis_entitlements.AssignedServiceResponseObject
 	Plan       cis_entitlements.AssignedServicePlanResponseObject
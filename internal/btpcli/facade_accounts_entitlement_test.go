@@ -105,6 +105,49 @@ func TestAccountsEntitlementFacade_ListByDirectory(t *testing.T) {
 	})
 }
 
+func TestAccountsEntitlementFacade_AssignToSubaccounts(t *testing.T) {
+	command := "accounts/entitlement"
+
+	directoryId := "my-directory-id"
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	amount := float64(2)
+	enable := false
+	assignments := []SubaccountServicePlanAssignment{
+		{
+			ServiceName:     "hana-cloud",
+			ServicePlanName: "hana",
+			AssignmentInfo:  []SubaccountServicePlanAssignmentInfo{{SubaccountGUID: subaccountId, Amount: &amount}},
+		},
+		{
+			ServiceName:     "alert-notification",
+			ServicePlanName: "free",
+			AssignmentInfo:  []SubaccountServicePlanAssignmentInfo{{SubaccountGUID: subaccountId, Enable: &enable}},
+		},
+	}
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionAssign, map[string]string{
+				"globalAccount":          "795b53bb-a3f0-4769-adf0-26173282a975",
+				"directoryID":            directoryId,
+				"subaccountServicePlans": `[{"assignmentInfo":[{"amount":2,"subaccountGUID":"6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"}],"serviceName":"hana-cloud","servicePlanName":"hana"},{"assignmentInfo":[{"enable":false,"subaccountGUID":"6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"}],"serviceName":"alert-notification","servicePlanName":"free"}]`,
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Accounts.Entitlement.AssignToSubaccounts(context.TODO(), directoryId, assignments)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
 func TestAccountsEntitlementFacade_AssignToSubaccount(t *testing.T) {
 	command := "accounts/entitlement"
 
@@ -168,6 +168,27 @@ func (f *accountsSubaccountFacade) Restore(ctx context.Context, subaccountId str
 	return doExecute[cis.SubaccountResponseObject](f.cliClient, ctx, NewRestoreRequest(f.getCommand(), params))
 }
 
+// Move moves the subaccount to the target directory. If no target directory is given, the subaccount is moved to the global account.
+func (f *accountsSubaccountFacade) Move(ctx context.Context, subaccountId string, sourceDirectoryId string, targetDirectoryId string) (cis.SubaccountResponseObject, CommandResponse, error) {
+	params := map[string]string{
+		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
+		"subaccount":    subaccountId,
+	}
+
+	if len(sourceDirectoryId) > 0 {
+		//if the parent is a managed directory, the directoryID must be set to make sure the right authorizations are validated
+		params["directoryID"] = sourceDirectoryId
+	}
+
+	if len(targetDirectoryId) > 0 {
+		params["toDirectory"] = targetDirectoryId
+	} else {
+		params["toGlobalAccount"] = f.cliClient.GetGlobalAccountSubdomain()
+	}
+
+	return doExecute[cis.SubaccountResponseObject](f.cliClient, ctx, NewMoveRequest(f.getCommand(), params))
+}
+
 func (f *accountsSubaccountFacade) AddMeAsAdmin(ctx context.Context, subaccountId string) (CommandResponse, error) {
 	res, err := f.cliClient.Execute(ctx, NewUpdateRequest(f.getCommand(), map[string]string{
 		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
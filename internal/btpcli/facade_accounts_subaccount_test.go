@@ -338,3 +338,53 @@ func TestAccountsSubaccountFacade_Restore(t *testing.T) {
 		}
 	})
 }
+
+func TestAccountsSubaccountFacade_Move(t *testing.T) {
+	command := "accounts/subaccount"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	sourceDirectoryId := "5357bda0-8651-4eab-a69d-12d282bc3247"
+	targetDirectoryId := "1b2c3d4e-8651-4eab-a69d-12d282bc3247"
+
+	t.Run("constructs the CLI params correctly - move to directory", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionMove, map[string]string{
+				"globalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+				"subaccount":    subaccountId,
+				"toDirectory":   targetDirectoryId,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subaccount.Move(context.TODO(), subaccountId, "", targetDirectoryId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - move to global account", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionMove, map[string]string{
+				"globalAccount":   "795b53bb-a3f0-4769-adf0-26173282a975",
+				"subaccount":      subaccountId,
+				"directoryID":     sourceDirectoryId,
+				"toGlobalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subaccount.Move(context.TODO(), subaccountId, sourceDirectoryId, "")
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
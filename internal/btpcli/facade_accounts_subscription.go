@@ -2,10 +2,14 @@ package btpcli
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/saas_manager_service"
 )
 
+const errMarshalDependencyCustomProperties = "failed to marshal subscription dependency custom properties: %w"
+
 func newAccountsSubscriptionFacade(cliClient *v2Client) accountsSubscriptionFacade {
 	return accountsSubscriptionFacade{cliClient: cliClient}
 }
@@ -18,6 +22,10 @@ func (f *accountsSubscriptionFacade) getCommand() string {
 	return "accounts/subscription"
 }
 
+func (f *accountsSubscriptionFacade) getDependencyCommand() string {
+	return "accounts/subscription-dependency"
+}
+
 func (f *accountsSubscriptionFacade) List(ctx context.Context, subaccountId string) ([]saas_manager_service.EntitledApplicationsResponseObject, CommandResponse, error) {
 	type wrapper struct { // TODO should be in types package
 		Applications []saas_manager_service.EntitledApplicationsResponseObject `json:"applications"`
@@ -59,3 +67,30 @@ func (f *accountsSubscriptionFacade) Update(ctx context.Context, subaccountId st
 
 	return doExecute[saas_manager_service.EntitledApplicationsResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
 }
+
+// GetDependencies returns the dependency tree of the subscription of a subaccount to a multitenant application. The root of the tree is the application itself.
+func (f *accountsSubscriptionFacade) GetDependencies(ctx context.Context, subaccountId string, appName string) (saas_manager_service.DependenciesResponseObject, CommandResponse, error) {
+	return doExecute[saas_manager_service.DependenciesResponseObject](f.cliClient, ctx, NewGetRequest(f.getDependencyCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"appName":    appName,
+	}))
+}
+
+// UpdateDependencies triggers the update of the dependencies of the subscription. The custom properties of the payload are handed over to the dependent reuse services.
+func (f *accountsSubscriptionFacade) UpdateDependencies(ctx context.Context, subaccountId string, appName string, payload saas_manager_service.UpdateApplicationDependenciesRequestPayload) (saas_manager_service.DependenciesResponseObject, CommandResponse, error) {
+	params := map[string]string{
+		"subaccount": subaccountId,
+		"appName":    appName,
+	}
+
+	if payload.AppProviderCustomProperties != nil {
+		jsonBytes, err := json.Marshal(payload.AppProviderCustomProperties)
+		if err != nil {
+			return saas_manager_service.DependenciesResponseObject{}, CommandResponse{}, fmt.Errorf(errMarshalDependencyCustomProperties, err)
+		}
+
+		params["appProviderCustomProperties"] = string(jsonBytes)
+	}
+
+	return doExecute[saas_manager_service.DependenciesResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getDependencyCommand(), params))
+}
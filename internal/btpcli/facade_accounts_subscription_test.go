@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/saas_manager_service"
 )
 
 func TestAccountsSubscriptionFacade_List(t *testing.T) {
@@ -135,3 +137,82 @@ func TestAccountsSubscriptionFacade_Update(t *testing.T) {
 		}
 	})
 }
+
+func TestAccountsSubscriptionFacade_GetDependencies(t *testing.T) {
+	command := "accounts/subscription-dependency"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	appName := "content-agent-ui"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"subaccount": subaccountId,
+				"appName":    appName,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subscription.GetDependencies(context.TODO(), subaccountId, appName)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestAccountsSubscriptionFacade_UpdateDependencies(t *testing.T) {
+	command := "accounts/subscription-dependency"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	appName := "content-agent-ui"
+
+	t.Run("constructs the CLI params correctly - without custom properties", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"subaccount": subaccountId,
+				"appName":    appName,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subscription.UpdateDependencies(context.TODO(), subaccountId, appName, saas_manager_service.UpdateApplicationDependenciesRequestPayload{})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	t.Run("constructs the CLI params correctly - with custom properties", func(t *testing.T) {
+		var srvCalled bool
+
+		var customProperties any = map[string]any{"key": "value"}
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"subaccount":                  subaccountId,
+				"appName":                     appName,
+				"appProviderCustomProperties": `{"key":"value"}`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Accounts.Subscription.UpdateDependencies(context.TODO(), subaccountId, appName, saas_manager_service.UpdateApplicationDependenciesRequestPayload{
+			AppProviderCustomProperties: &customProperties,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
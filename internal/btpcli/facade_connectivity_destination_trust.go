@@ -28,3 +28,26 @@ func (f *connectivityDestinationTrustFacade) GetBySubaccount(ctx context.Context
 		"passive":    passive,
 	}))
 }
+
+// GenerateBySubaccount generates a new active or passive destination trust. An existing trust of the same type is replaced.
+func (f *connectivityDestinationTrustFacade) GenerateBySubaccount(ctx context.Context, subaccountID string, trustType bool) (connectivity.DestinationTrust, CommandResponse, error) {
+	passive := "true"
+	if trustType {
+		passive = "false"
+	}
+	return doExecute[connectivity.DestinationTrust](f.cliClient, ctx, NewCreateRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountID,
+		"passive":    passive,
+	}))
+}
+
+func (f *connectivityDestinationTrustFacade) DeleteBySubaccount(ctx context.Context, subaccountID string, trustType bool) (CommandResponse, error) {
+	passive := "true"
+	if trustType {
+		passive = "false"
+	}
+	return f.cliClient.Execute(ctx, NewDeleteRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountID,
+		"passive":    passive,
+	}))
+}
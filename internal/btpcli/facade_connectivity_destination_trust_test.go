@@ -52,3 +52,51 @@ func TestConnectivityDestinationTrustFacade_GetBySubaccount(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectivityDestinationTrustFacade_GenerateBySubaccount(t *testing.T) {
+	command := "connectivity/destination-trust"
+
+	subaccountId := "12345678-aaaa-bbbb-cccc-123456789000"
+
+	var srvCalled bool
+
+	uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srvCalled = true
+
+		assertCall(t, r, command, ActionCreate, map[string]string{
+			"subaccount": subaccountId,
+			"passive":    "true",
+		})
+	}))
+	defer srv.Close()
+
+	_, res, err := uut.Connectivity.DestinationTrust.GenerateBySubaccount(context.TODO(), subaccountId, false)
+
+	if assert.True(t, srvCalled) && assert.NoError(t, err) {
+		assert.Equal(t, 200, res.StatusCode)
+	}
+}
+
+func TestConnectivityDestinationTrustFacade_DeleteBySubaccount(t *testing.T) {
+	command := "connectivity/destination-trust"
+
+	subaccountId := "12345678-aaaa-bbbb-cccc-123456789000"
+
+	var srvCalled bool
+
+	uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srvCalled = true
+
+		assertCall(t, r, command, ActionDelete, map[string]string{
+			"subaccount": subaccountId,
+			"passive":    "true",
+		})
+	}))
+	defer srv.Close()
+
+	res, err := uut.Connectivity.DestinationTrust.DeleteBySubaccount(context.TODO(), subaccountId, false)
+
+	if assert.True(t, srvCalled) && assert.NoError(t, err) {
+		assert.Equal(t, 200, res.StatusCode)
+	}
+}
@@ -96,3 +96,26 @@ func (f *securityApiCredentialFacade) GetByGlobalAccount(ctx context.Context, ar
 
 	return doExecute[xsuaa_api.ApiCredential](f.cliClient, ctx, NewGetRequest(f.getCommand(), params))
 }
+
+func (f *securityApiCredentialFacade) ListByDirectoryorSubaccount(ctx context.Context, args *ApiCredentialInput) ([]xsuaa_api.ApiCredential, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return []xsuaa_api.ApiCredential{}, CommandResponse{}, err
+	}
+
+	return doExecute[[]xsuaa_api.ApiCredential](f.cliClient, ctx, NewListRequest(f.getCommand(), params))
+}
+
+func (f *securityApiCredentialFacade) ListByGlobalAccount(ctx context.Context, args *ApiCredentialInput) ([]xsuaa_api.ApiCredential, CommandResponse, error) {
+
+	args.GlobalAccount = f.cliClient.GetGlobalAccountSubdomain()
+
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return []xsuaa_api.ApiCredential{}, CommandResponse{}, err
+	}
+
+	return doExecute[[]xsuaa_api.ApiCredential](f.cliClient, ctx, NewListRequest(f.getCommand(), params))
+}
@@ -355,3 +355,81 @@ func TestSecurityApiCredential_GetByGlobalAccount(t *testing.T) {
 		}
 	})
 }
+
+func TestSecurityApiCredential_ListByDirectoryorSubaccount(t *testing.T) {
+
+	command := "security/api-credential"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+
+	t.Run("constructs the CLI params correctly - subaccount", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"subaccount": subaccountId,
+				"readOnly":   "false",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.ListByDirectoryorSubaccount(context.TODO(), &ApiCredentialInput{
+			Subaccount: subaccountId,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+
+	directoryId := "5357bda0-8651-4eab-a69d-12d282bc3247"
+
+	t.Run("constructs the CLI params correctly - directory", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"directory": directoryId,
+				"readOnly":  "false",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.ListByDirectoryorSubaccount(context.TODO(), &ApiCredentialInput{
+			Directory: directoryId,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityApiCredential_ListByGlobalAccount(t *testing.T) {
+
+	command := "security/api-credential"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"globalAccount": "795b53bb-a3f0-4769-adf0-26173282a975",
+				"readOnly":      "false",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.ApiCredential.ListByGlobalAccount(context.TODO(), &ApiCredentialInput{})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
@@ -31,6 +31,12 @@ func (f *securitySettingsFacade) ListBySubaccount(ctx context.Context, subaccoun
 	}))
 }
 
+func (f *securitySettingsFacade) ListByDirectory(ctx context.Context, directoryId string) (xsuaa_settings.TenantSettingsResp, CommandResponse, error) {
+	return doExecute[xsuaa_settings.TenantSettingsResp](f.cliClient, ctx, NewListRequest(f.getCommand(), map[string]string{
+		"directory": directoryId,
+	}))
+}
+
 type SecuritySettingsUpdateInput struct {
 	IFrame                            string   `btpcli:"iFrameDomain"`
 	CustomEmail                       []string `btpcli:"customEmailDomains,json"`
@@ -66,3 +72,15 @@ func (f *securitySettingsFacade) UpdateBySubaccount(ctx context.Context, subacco
 
 	return doExecute[xsuaa_settings.TenantSettingsResp](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
 }
+
+func (f *securitySettingsFacade) UpdateByDirectory(ctx context.Context, directoryId string, args SecuritySettingsUpdateInput) (xsuaa_settings.TenantSettingsResp, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return xsuaa_settings.TenantSettingsResp{}, CommandResponse{}, err
+	}
+
+	params["directory"] = directoryId
+
+	return doExecute[xsuaa_settings.TenantSettingsResp](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
+}
@@ -141,3 +141,72 @@ func TestSecuritySettingsFacade_UpdateBySubaccount(t *testing.T) {
 		}
 	})
 }
+
+func TestSecuritySettingsFacade_ListByDirectory(t *testing.T) {
+	command := "security/settings"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"directory": directoryId,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Settings.ListByDirectory(context.TODO(), directoryId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecuritySettingsFacade_UpdateByDirectory(t *testing.T) {
+	command := "security/settings"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"directory":                         directoryId,
+				"iFrameDomain":                      "https://my-iframe-domain-1",
+				"customEmailDomains":                "[\"customemaildomain1.com\",\"customemaildomain2.com\"]",
+				"defaultIdp":                        "my-idp",
+				"treatUsersWithSameEmailAsSameUser": "true",
+				"homeRedirect":                      "my-new-redirect",
+				"accessTokenValidity":               "3600",
+				"refreshTokenValidity":              "3600",
+				"rotateSigningKeyAutomatically":     "false",
+				"useIdpUserNameInTokens":            "false",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Settings.UpdateByDirectory(context.TODO(), directoryId, SecuritySettingsUpdateInput{
+			IFrame:                            "https://my-iframe-domain-1",
+			CustomEmail:                       []string{"customemaildomain1.com", "customemaildomain2.com"},
+			DefaultIDPForNonInteractiveLogon:  "my-idp",
+			TreatUsersWithSameEmailAsSameUser: true,
+			HomeRedirect:                      "my-new-redirect",
+			AccessTokenValidity:               3600,
+			RefreshTokenValidity:              3600,
+			AutoRotateSigningKey:              false,
+			UseIdpUserNameInTokens:            false,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
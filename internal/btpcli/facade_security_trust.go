@@ -45,15 +45,28 @@ func (f *securityTrustFacade) GetBySubaccount(ctx context.Context, subaccountId
 	}))
 }
 
+func (f *securityTrustFacade) ListByDirectory(ctx context.Context, directoryId string) (xsuaa_trust.TrustConfigurationResponseCollectionObject, CommandResponse, error) {
+	return doExecute[xsuaa_trust.TrustConfigurationResponseCollectionObject](f.cliClient, ctx, NewListRequest(f.getCommand(), map[string]string{
+		"directory": directoryId,
+	}))
+}
+
+func (f *securityTrustFacade) GetByDirectory(ctx context.Context, directoryId string, origin string) (xsuaa_trust.TrustConfigurationResponseObject, CommandResponse, error) {
+	return doExecute[xsuaa_trust.TrustConfigurationResponseObject](f.cliClient, ctx, NewGetRequest(f.getCommand(), map[string]string{
+		"directory": directoryId,
+		"origin":    origin,
+	}))
+}
+
 type TrustConfigurationCreateInput struct {
 	IdentityProvider      string  `btpcli:"iasTenantUrl"`
 	Name                  *string `btpcli:"name"`
 	Description           *string `btpcli:"description"`
 	Origin                *string `btpcli:"origin"`
 	Domain                *string `btpcli:"domain"`
-	LinkText              *string `btpcli:"linkText"`    // subaccount only
-	AvailableForUserLogon *bool   `btpcli:"userLogon"`   // subaccount only
-	AutoCreateShadowUsers *bool   `btpcli:"shadowUsers"` // subaccount only
+	LinkText              *string `btpcli:"linkText"`    // subaccount and directory only
+	AvailableForUserLogon *bool   `btpcli:"userLogon"`   // subaccount and directory only
+	AutoCreateShadowUsers *bool   `btpcli:"shadowUsers"` // subaccount and directory only
 }
 
 func (f *securityTrustFacade) CreateByGlobalAccount(ctx context.Context, args TrustConfigurationCreateInput) (xsuaa_trust.ModifyTrustConfigurationResponseObject, CommandResponse, error) {
@@ -80,15 +93,27 @@ func (f *securityTrustFacade) CreateBySubaccount(ctx context.Context, subaccount
 	return doExecute[xsuaa_trust.ModifyTrustConfigurationResponseObject](f.cliClient, ctx, NewCreateRequest(f.getCommand(), params))
 }
 
+func (f *securityTrustFacade) CreateByDirectory(ctx context.Context, directoryId string, args TrustConfigurationCreateInput) (xsuaa_trust.ModifyTrustConfigurationResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return xsuaa_trust.ModifyTrustConfigurationResponseObject{}, CommandResponse{}, err
+	}
+
+	params["directory"] = directoryId
+
+	return doExecute[xsuaa_trust.ModifyTrustConfigurationResponseObject](f.cliClient, ctx, NewCreateRequest(f.getCommand(), params))
+}
+
 type TrustConfigurationUpdateInput struct {
 	OriginKey             string  `btpcli:"originKey"`
 	IdentityProvider      *string `btpcli:"iasTenantUrl"`
 	Name                  *string `btpcli:"name"`
 	Description           *string `btpcli:"description"`
 	Domain                *string `btpcli:"domain"`
-	LinkText              *string `btpcli:"linkText"`    // subaccount only
-	AvailableForUserLogon *bool   `btpcli:"userLogon"`   // subaccount only
-	AutoCreateShadowUsers *bool   `btpcli:"shadowUsers"` // subaccount only
+	LinkText              *string `btpcli:"linkText"`    // subaccount and directory only
+	AvailableForUserLogon *bool   `btpcli:"userLogon"`   // subaccount and directory only
+	AutoCreateShadowUsers *bool   `btpcli:"shadowUsers"` // subaccount and directory only
 	Status                *string `btpcli:"status"`
 }
 
@@ -118,6 +143,19 @@ func (f *securityTrustFacade) UpdateBySubaccount(ctx context.Context, subaccount
 	return doExecute[xsuaa_trust.TrustConfigurationResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
 }
 
+func (f *securityTrustFacade) UpdateByDirectory(ctx context.Context, directoryId string, args TrustConfigurationUpdateInput) (xsuaa_trust.TrustConfigurationResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return xsuaa_trust.TrustConfigurationResponseObject{}, CommandResponse{}, err
+	}
+
+	params["directory"] = directoryId
+	// refreshTrust is intentionally not set on update
+
+	return doExecute[xsuaa_trust.TrustConfigurationResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
+}
+
 func (f *securityTrustFacade) DeleteByGlobalAccount(ctx context.Context, originKey string) (xsuaa_trust.ModifyTrustConfigurationResponseObject, CommandResponse, error) {
 	return doExecute[xsuaa_trust.ModifyTrustConfigurationResponseObject](f.cliClient, ctx, NewDeleteRequest(f.getCommand(), map[string]string{
 		"globalAccount": f.cliClient.GetGlobalAccountSubdomain(),
@@ -133,3 +171,11 @@ func (f *securityTrustFacade) DeleteBySubaccount(ctx context.Context, subaccount
 		"confirm":    "true",
 	}))
 }
+
+func (f *securityTrustFacade) DeleteByDirectory(ctx context.Context, directoryId string, originKey string) (xsuaa_trust.ModifyTrustConfigurationResponseObject, CommandResponse, error) {
+	return doExecute[xsuaa_trust.ModifyTrustConfigurationResponseObject](f.cliClient, ctx, NewDeleteRequest(f.getCommand(), map[string]string{
+		"directory": directoryId,
+		"originKey": originKey,
+		"confirm":   "true",
+	}))
+}
@@ -442,3 +442,206 @@ func TestSecurityTrustFacade_DeleteBySubaccount(t *testing.T) {
 		}
 	})
 }
+
+func TestSecurityTrustFacade_ListByDirectory(t *testing.T) {
+	command := "security/trust"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionList, map[string]string{
+				"directory": directoryId,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.ListByDirectory(context.TODO(), directoryId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityTrustFacade_GetByDirectory(t *testing.T) {
+	command := "security/trust"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+	origin := "ldap"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionGet, map[string]string{
+				"directory": directoryId,
+				"origin":    origin,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.GetByDirectory(context.TODO(), directoryId, origin)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityTrustFacade_CreateByDirectory(t *testing.T) {
+	command := "security/trust"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+	idp := "my-ias-tentant.local"
+	name := "my-ias"
+	description := "this is a description for the ias tenant"
+	origin := "custom-origin-platform"
+	domain := "custom.domain"
+
+	t.Run("constructs the CLI params correctly - minimal", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"directory":    directoryId,
+				"iasTenantUrl": idp,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.CreateByDirectory(context.TODO(), directoryId, TrustConfigurationCreateInput{
+			IdentityProvider: idp,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - fully customized", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionCreate, map[string]string{
+				"directory":    directoryId,
+				"iasTenantUrl": idp,
+				"name":         name,
+				"description":  description,
+				"origin":       origin,
+				"domain":       domain,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.CreateByDirectory(context.TODO(), directoryId, TrustConfigurationCreateInput{
+			IdentityProvider: idp,
+			Name:             &name,
+			Description:      &description,
+			Origin:           &origin,
+			Domain:           &domain,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityTrustFacade_UpdateByDirectory(t *testing.T) {
+	command := "security/trust"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+	name := "my-ias"
+	description := "this is a description for the ias tenant"
+	originKey := "custom-origin-platform"
+	domain := "custom.domain"
+
+	t.Run("constructs the CLI params correctly - minimal", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"directory": directoryId,
+				"originKey": originKey,
+				"name":      name,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.UpdateByDirectory(context.TODO(), directoryId, TrustConfigurationUpdateInput{
+			OriginKey: originKey,
+			Name:      &name,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+	t.Run("constructs the CLI params correctly - fully customized", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"directory":   directoryId,
+				"originKey":   originKey,
+				"name":        name,
+				"description": description,
+				"domain":      domain,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.UpdateByDirectory(context.TODO(), directoryId, TrustConfigurationUpdateInput{
+			OriginKey:   originKey,
+			Name:        &name,
+			Description: &description,
+			Domain:      &domain,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestSecurityTrustFacade_DeleteByDirectory(t *testing.T) {
+	command := "security/trust"
+
+	directoryId := "f6c7137d-c5a0-48c2-b2a4-fd64e6b35d3d"
+	originKey := "my-idp-platform"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionDelete, map[string]string{
+				"directory": directoryId,
+				"originKey": originKey,
+				"confirm":   "true",
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Security.Trust.DeleteByDirectory(context.TODO(), directoryId, originKey)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
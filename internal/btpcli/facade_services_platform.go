@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/SAP/terraform-provider-btp/internal/btpcli/types/servicemanager"
+	"github.com/SAP/terraform-provider-btp/internal/tfutils"
 )
 
 func newServicesPlatformFacade(cliClient *v2Client) servicesPlatformFacade {
@@ -47,3 +48,48 @@ func (f servicesPlatformFacade) GetByName(ctx context.Context, subaccountId stri
 		"name":       platformName,
 	}))
 }
+
+type SubaccountServicePlatformRegisterInput struct {
+	Subaccount  string              `btpcli:"subaccount"`
+	Name        string              `btpcli:"name"`
+	Type        string              `btpcli:"type"`
+	Description string              `btpcli:"description"`
+	Labels      map[string][]string `btpcli:"labels"`
+}
+
+func (f servicesPlatformFacade) Register(ctx context.Context, args SubaccountServicePlatformRegisterInput) (servicemanager.RegisteredPlatformResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.RegisteredPlatformResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[servicemanager.RegisteredPlatformResponseObject](f.cliClient, ctx, NewRegisterRequest(f.getCommand(), params))
+}
+
+type SubaccountServicePlatformUpdateInput struct {
+	Id          string              `btpcli:"id"`
+	Subaccount  string              `btpcli:"subaccount"`
+	NewName     string              `btpcli:"newName"`
+	Description string              `btpcli:"description"`
+	Labels      map[string][]string `btpcli:"labels"`
+}
+
+func (f servicesPlatformFacade) Update(ctx context.Context, args SubaccountServicePlatformUpdateInput) (servicemanager.UpdatedPlatformResponseObject, CommandResponse, error) {
+	params, err := tfutils.ToBTPCLIParamsMap(args)
+
+	if err != nil {
+		return servicemanager.UpdatedPlatformResponseObject{}, CommandResponse{}, err
+	}
+
+	return doExecute[servicemanager.UpdatedPlatformResponseObject](f.cliClient, ctx, NewUpdateRequest(f.getCommand(), params))
+}
+
+func (f servicesPlatformFacade) Unregister(ctx context.Context, subaccountId string, platformId string) (CommandResponse, error) {
+	res, err := f.cliClient.Execute(ctx, NewUnregisterRequest(f.getCommand(), map[string]string{
+		"subaccount": subaccountId,
+		"id":         platformId,
+		"confirm":    "true",
+	}))
+	return res, err
+}
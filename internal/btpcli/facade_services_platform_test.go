@@ -144,3 +144,108 @@ func TestServicesPlatformFacade_GetByName(t *testing.T) {
 		}
 	})
 }
+
+func TestServicesPlatformFacade_Register(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformName := "my-platform"
+	platformType := "kubernetes"
+	description := "describes the platform"
+	labels := map[string][]string{
+		"a": {"b"},
+	}
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionRegister, map[string]string{
+				"subaccount":  subaccountId,
+				"name":        platformName,
+				"type":        platformType,
+				"description": description,
+				"labels":      `{"a":["b"]}`,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Services.Platform.Register(context.TODO(), SubaccountServicePlatformRegisterInput{
+			Subaccount:  subaccountId,
+			Name:        platformName,
+			Type:        platformType,
+			Description: description,
+			Labels:      labels,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestServicesPlatformFacade_Update(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformId := "76765dca-6683-473a-8f42-809e33a2ea68"
+	platformName := "my-platform"
+	description := "describes the platform"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUpdate, map[string]string{
+				"subaccount":  subaccountId,
+				"id":          platformId,
+				"newName":     platformName,
+				"description": description,
+			})
+		}))
+		defer srv.Close()
+
+		_, res, err := uut.Services.Platform.Update(context.TODO(), SubaccountServicePlatformUpdateInput{
+			Subaccount:  subaccountId,
+			Id:          platformId,
+			NewName:     platformName,
+			Description: description,
+		})
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
+
+func TestServicesPlatformFacade_Unregister(t *testing.T) {
+	command := "services/platform"
+
+	subaccountId := "6aa64c2f-38c1-49a9-b2e8-cf9fea769b7f"
+	platformId := "76765dca-6683-473a-8f42-809e33a2ea68"
+
+	t.Run("constructs the CLI params correctly", func(t *testing.T) {
+		var srvCalled bool
+
+		uut, srv := prepareClientFacadeForTest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srvCalled = true
+
+			assertCall(t, r, command, ActionUnregister, map[string]string{
+				"subaccount": subaccountId,
+				"id":         platformId,
+				"confirm":    "true",
+			})
+		}))
+		defer srv.Close()
+
+		res, err := uut.Services.Platform.Unregister(context.TODO(), subaccountId, platformId)
+
+		if assert.True(t, srvCalled) && assert.NoError(t, err) {
+			assert.Equal(t, 200, res.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package btpcli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	envGitHubTokenRequestURL   string = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	envGitHubTokenRequestToken string = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+)
+
+// oidcHttpClient is used to request and exchange OIDC tokens. Those requests do not target the
+// CLI server, so they are neither retried nor rate limited.
+var oidcHttpClient = &http.Client{Timeout: 30 * time.Second}
+
+// OIDCLogin authenticates with the OIDC token of a workload identity, e.g. of a CI pipeline. The token is
+// optionally exchanged at the token endpoint of the identity provider and then used as assertion for the login.
+func (v2 *v2Client) OIDCLogin(ctx context.Context, loginReq *OIDCLoginRequest) (*LoginResponse, error) {
+	token, err := fetchOIDCToken(ctx, loginReq)
+	if err != nil {
+		return nil, err
+	}
+
+	loginResponse, err := v2.cachedLogin(ctx, loginReq.GlobalAccountSubdomain, loginReq.IdentityProvider, jwtSubject(token), "", func(ctx context.Context) (*LoginResponse, error) {
+		return v2.oidcLoginWithToken(ctx, loginReq, token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// OIDC tokens of CI pipelines are short-lived, so a new one has to be requested to renew the session
+	v2.setRenewSession(func(ctx context.Context) error {
+		_, err := v2.oidcLogin(ctx, loginReq)
+		return err
+	})
+
+	return loginResponse, nil
+}
+
+func (v2 *v2Client) oidcLogin(ctx context.Context, loginReq *OIDCLoginRequest) (*LoginResponse, error) {
+	token, err := fetchOIDCToken(ctx, loginReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2.oidcLoginWithToken(ctx, loginReq, token)
+}
+
+func (v2 *v2Client) oidcLoginWithToken(ctx context.Context, loginReq *OIDCLoginRequest, token string) (*LoginResponse, error) {
+	var err error
+
+	if len(loginReq.TokenExchangeURL) > 0 {
+		if token, err = exchangeOIDCToken(ctx, loginReq, token); err != nil {
+			return nil, err
+		}
+	}
+
+	return v2.login(ctx, NewLoginRequestWithAssertion(loginReq.IdentityProvider, loginReq.GlobalAccountSubdomain, token))
+}
+
+// fetchOIDCToken reads the OIDC token from a file or an environment variable, or requests it from the
+// GitHub Actions token endpoint
+func fetchOIDCToken(ctx context.Context, loginReq *OIDCLoginRequest) (string, error) {
+	var token string
+
+	switch {
+	case len(loginReq.TokenFile) > 0:
+		content, err := os.ReadFile(loginReq.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("read OIDC token file: %w", err)
+		}
+		token = string(content)
+	case len(loginReq.TokenEnvVar) > 0:
+		token = os.Getenv(loginReq.TokenEnvVar)
+	case len(os.Getenv(envGitHubTokenRequestURL)) > 0:
+		var err error
+		if token, err = requestGitHubActionsToken(ctx, loginReq.Audience); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("no OIDC token source found. Provide a token file or an environment variable containing the token, or run in GitHub Actions with the permission 'id-token: write'")
+	}
+
+	token = strings.TrimSpace(token)
+	if len(token) == 0 {
+		return "", fmt.Errorf("the OIDC token is empty")
+	}
+
+	return token, nil
+}
+
+// requestGitHubActionsToken requests an OIDC token for the given audience from the token endpoint of GitHub Actions
+func requestGitHubActionsToken(ctx context.Context, audience string) (string, error) {
+	requestURL, err := url.Parse(os.Getenv(envGitHubTokenRequestURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid value of %s: %w", envGitHubTokenRequestURL, err)
+	}
+
+	if len(audience) > 0 {
+		query := requestURL.Query()
+		query.Set("audience", audience)
+		requestURL.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+os.Getenv(envGitHubTokenRequestToken))
+	req.Header.Set("Accept", "application/json")
+
+	res, err := oidcHttpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request OIDC token from GitHub Actions: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub Actions token endpoint responded with unexpected response code: %d", res.StatusCode)
+	}
+
+	var tokenResponse struct {
+		Value string `json:"value"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("decode OIDC token response of GitHub Actions: %w", err)
+	}
+
+	return tokenResponse.Value, nil
+}
+
+// exchangeOIDCToken exchanges the OIDC token for an id token of the identity provider (RFC 8693)
+func exchangeOIDCToken(ctx context.Context, loginReq *OIDCLoginRequest, token string) (string, error) {
+	form := url.Values{
+		"grant_type":           {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"subject_token":        {token},
+		"subject_token_type":   {"urn:ietf:params:oauth:token-type:jwt"},
+		"requested_token_type": {"urn:ietf:params:oauth:token-type:id_token"},
+		"client_id":            {loginReq.ClientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, loginReq.TokenExchangeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if len(loginReq.ClientSecret) > 0 {
+		req.SetBasicAuth(url.QueryEscape(loginReq.ClientID), url.QueryEscape(loginReq.ClientSecret))
+	}
+
+	res, err := oidcHttpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("exchange OIDC token: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return "", fmt.Errorf("token exchange endpoint responded with unexpected response code: %d %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResponse struct {
+		IdToken     string `json:"id_token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("decode token exchange response: %w", err)
+	}
+
+	if len(tokenResponse.IdToken) > 0 {
+		return tokenResponse.IdToken, nil
+	}
+
+	if len(tokenResponse.AccessToken) > 0 {
+		return tokenResponse.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("token exchange response contains neither an id token nor an access token")
+}
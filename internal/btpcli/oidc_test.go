@@ -0,0 +1,186 @@
+package btpcli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchOIDCToken(t *testing.T) {
+	t.Run("token from file", func(t *testing.T) {
+		t.Setenv(envGitHubTokenRequestURL, "")
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		assert.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+
+		token, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{TokenFile: tokenFile})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "file-token", token)
+	})
+	t.Run("token from missing file", func(t *testing.T) {
+		_, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{TokenFile: filepath.Join(t.TempDir(), "missing")})
+
+		assert.ErrorContains(t, err, "read OIDC token file")
+	})
+	t.Run("token from env", func(t *testing.T) {
+		t.Setenv("MY_OIDC_TOKEN", "env-token")
+
+		token, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{TokenEnvVar: "MY_OIDC_TOKEN"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "env-token", token)
+	})
+	t.Run("token from empty env", func(t *testing.T) {
+		t.Setenv("MY_OIDC_TOKEN", "")
+
+		_, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{TokenEnvVar: "MY_OIDC_TOKEN"})
+
+		assert.EqualError(t, err, "the OIDC token is empty")
+	})
+	t.Run("token from GitHub Actions", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+			assert.Equal(t, "1", r.URL.Query().Get("api-version"))
+			assert.Equal(t, "my-audience", r.URL.Query().Get("audience"))
+
+			_, _ = fmt.Fprintf(w, `{"count":1,"value":"github-token"}`)
+		}))
+		defer srv.Close()
+
+		t.Setenv(envGitHubTokenRequestURL, srv.URL+"?api-version=1")
+		t.Setenv(envGitHubTokenRequestToken, "request-token")
+
+		token, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{Audience: "my-audience"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "github-token", token)
+	})
+	t.Run("token from GitHub Actions - error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		t.Setenv(envGitHubTokenRequestURL, srv.URL)
+		t.Setenv(envGitHubTokenRequestToken, "request-token")
+
+		_, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{})
+
+		assert.EqualError(t, err, "GitHub Actions token endpoint responded with unexpected response code: 403")
+	})
+	t.Run("no token source", func(t *testing.T) {
+		t.Setenv(envGitHubTokenRequestURL, "")
+
+		_, err := fetchOIDCToken(context.TODO(), &OIDCLoginRequest{})
+
+		assert.ErrorContains(t, err, "no OIDC token source found")
+	})
+}
+
+func TestExchangeOIDCToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "ci-token", r.PostForm.Get("subject_token"))
+			assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+
+			clientID, clientSecret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "client-id", clientID)
+			assert.Equal(t, "client-secret", clientSecret)
+
+			_, _ = fmt.Fprintf(w, `{"id_token":"ias-token","access_token":"access-token"}`)
+		}))
+		defer srv.Close()
+
+		token, err := exchangeOIDCToken(context.TODO(), &OIDCLoginRequest{
+			TokenExchangeURL: srv.URL,
+			ClientID:         "client-id",
+			ClientSecret:     "client-secret",
+		}, "ci-token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ias-token", token)
+	})
+	t.Run("public client", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _, ok := r.BasicAuth()
+			assert.False(t, ok)
+
+			_, _ = fmt.Fprintf(w, `{"access_token":"access-token"}`)
+		}))
+		defer srv.Close()
+
+		token, err := exchangeOIDCToken(context.TODO(), &OIDCLoginRequest{TokenExchangeURL: srv.URL, ClientID: "client-id"}, "ci-token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", token)
+	})
+	t.Run("error path", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"error":"invalid_grant"}`)
+		}))
+		defer srv.Close()
+
+		_, err := exchangeOIDCToken(context.TODO(), &OIDCLoginRequest{TokenExchangeURL: srv.URL, ClientID: "client-id"}, "ci-token")
+
+		assert.EqualError(t, err, `token exchange endpoint responded with unexpected response code: 400 {"error":"invalid_grant"}`)
+	})
+}
+
+func TestV2Client_OIDCLogin(t *testing.T) {
+	t.Setenv("MY_OIDC_TOKEN", "ci-token")
+
+	idpSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		_, _ = fmt.Fprintf(w, `{"id_token":"exchanged-%s"}`, r.PostForm.Get("subject_token"))
+	}))
+	defer idpSrv.Close()
+
+	cliSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var loginReq LoginRequest
+		assert.NoError(t, json.Unmarshal(b, &loginReq))
+		if assert.NotNil(t, loginReq.Jwt) {
+			assert.Equal(t, "exchanged-ci-token", *loginReq.Jwt)
+		}
+		assert.Equal(t, "my.custom.idp", loginReq.IdentityProvider)
+
+		w.Header().Set(HeaderCLISessionId, "sessionid")
+		_, _ = fmt.Fprintf(w, `{"issuer": "my.custom.idp","user":"pipeline","mail":"pipeline@test.com"}`)
+	}))
+	defer cliSrv.Close()
+
+	srvUrl, _ := url.Parse(cliSrv.URL)
+	uut := NewV2ClientWithHttpClient(cliSrv.Client(), srvUrl, nil)
+
+	res, err := uut.OIDCLogin(context.TODO(), &OIDCLoginRequest{
+		GlobalAccountSubdomain: "subdomain",
+		IdentityProvider:       "my.custom.idp",
+		TokenEnvVar:            "MY_OIDC_TOKEN",
+		TokenExchangeURL:       idpSrv.URL,
+		ClientID:               "client-id",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &LoginResponse{Issuer: "my.custom.idp", Email: "pipeline@test.com"}, res)
+	assert.Equal(t, "sessionid", uut.session.SessionId)
+	assert.NotNil(t, uut.renewSession)
+}
@@ -0,0 +1,326 @@
+package btpcli
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig configures the client-side throttling of requests to the BTP CLI server. A value of zero
+// for RequestsPerSecond or MaxConcurrentRequests means that the respective limit is not enforced.
+type RateLimitConfig struct {
+	RequestsPerSecond     float64
+	Burst                 int
+	MaxConcurrentRequests int
+	// CommandLimits are additional limits for commands starting with a given prefix (e.g. "security/").
+	// A request is subject to the global limits and to the limits of the most specific matching prefix.
+	CommandLimits []CommandRateLimitConfig
+}
+
+// CommandRateLimitConfig configures the throttling of all commands that start with CommandPrefix.
+type CommandRateLimitConfig struct {
+	CommandPrefix         string
+	RequestsPerSecond     float64
+	Burst                 int
+	MaxConcurrentRequests int
+}
+
+// DefaultRateLimitConfig returns the rate limit configuration that is used if no explicit configuration is provided.
+// Requests are not throttled, but a Retry-After header sent by the server pauses all subsequent requests.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{}
+}
+
+// UseRateLimit replaces the default throttling of the requests by the given configuration. It must be called before the first request.
+func (v2 *v2Client) UseRateLimit(cfg *RateLimitConfig) {
+	v2.rateLimit.configure(cfg)
+}
+
+// injectRateLimitTransport returns a shallow copy of the given client whose transport throttles the requests
+// according to the given configuration. The given client is left untouched.
+func injectRateLimitTransport(client *http.Client, cfg *RateLimitConfig) *http.Client {
+	parentTransport := http.DefaultTransport
+
+	if client.Transport != nil {
+		parentTransport = client.Transport
+	}
+
+	limitedClient := *client
+	limitedClient.Transport = newRateLimitTransport(parentTransport, cfg)
+	return &limitedClient
+}
+
+// rateLimitTransport implements the http.RoundTripper interface. It delays requests according to a token
+// bucket, caps the number of requests in flight and honours Retry-After headers of throttled responses.
+// As it sits below the retrying client, every single attempt of a request is throttled.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+
+	global   *requestLimiter
+	commands []*requestLimiter
+}
+
+func newRateLimitTransport(transport http.RoundTripper, cfg *RateLimitConfig) *rateLimitTransport {
+	rt := &rateLimitTransport{
+		transport: transport,
+	}
+
+	rt.configure(cfg)
+
+	return rt
+}
+
+// configure replaces the limiters of the transport by the ones of the given configuration
+func (rt *rateLimitTransport) configure(cfg *RateLimitConfig) {
+	if cfg == nil {
+		cfg = DefaultRateLimitConfig()
+	}
+
+	rt.global = newRequestLimiter("", cfg.RequestsPerSecond, cfg.Burst, cfg.MaxConcurrentRequests)
+	rt.commands = nil
+
+	for _, commandCfg := range cfg.CommandLimits {
+		rt.commands = append(rt.commands, newRequestLimiter(commandCfg.CommandPrefix, commandCfg.RequestsPerSecond, commandCfg.Burst, commandCfg.MaxConcurrentRequests))
+	}
+}
+
+// limitersFor returns the limiters that apply to the given command, the most specific one first
+func (rt *rateLimitTransport) limitersFor(command string) []*requestLimiter {
+	var match *requestLimiter
+
+	if len(command) > 0 {
+		for _, limiter := range rt.commands {
+			if strings.HasPrefix(command, limiter.prefix) && (match == nil || len(limiter.prefix) > len(match.prefix)) {
+				match = limiter
+			}
+		}
+	}
+
+	if match == nil {
+		return []*requestLimiter{rt.global}
+	}
+
+	return []*requestLimiter{match, rt.global}
+}
+
+func (rt *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiters := rt.limitersFor(commandFromPath(req.URL.Path))
+
+	for i, limiter := range limiters {
+		if err := limiter.acquire(req.Context()); err != nil {
+			for _, acquired := range limiters[:i] {
+				acquired.release()
+			}
+			return nil, err
+		}
+	}
+
+	defer func() {
+		for _, limiter := range limiters {
+			limiter.release()
+		}
+	}()
+
+	res, err := rt.transport.RoundTrip(req)
+
+	if err == nil && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			for _, limiter := range limiters {
+				limiter.bucket.pauseUntil(time.Now().Add(wait))
+			}
+		}
+	}
+
+	return res, err
+}
+
+// commandFromPath extracts the command (e.g. "security/role-collection") from the path of a command request.
+// For other requests, e.g. logins, an empty string is returned.
+func commandFromPath(urlPath string) string {
+	segments := strings.SplitN(strings.TrimPrefix(path.Clean(urlPath), "/"), "/", 3)
+
+	if len(segments) < 3 || segments[0] != "command" {
+		return ""
+	}
+
+	return segments[2]
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	retryTime, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	if wait := retryTime.Sub(now); wait > 0 {
+		return wait, true
+	}
+
+	return 0, true
+}
+
+// requestLimiter combines a token bucket with a cap on the number of requests in flight
+type requestLimiter struct {
+	prefix string
+	bucket *tokenBucket
+	slots  chan struct{}
+}
+
+func newRequestLimiter(prefix string, requestsPerSecond float64, burst int, maxConcurrentRequests int) *requestLimiter {
+	limiter := &requestLimiter{
+		prefix: prefix,
+		bucket: newTokenBucket(requestsPerSecond, burst),
+	}
+
+	if maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+// acquire blocks until a request slot and a token are available or the context is done
+func (l *requestLimiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := l.bucket.wait(ctx); err != nil {
+		l.release()
+		return err
+	}
+
+	return nil
+}
+
+// release frees the request slot taken by acquire
+func (l *requestLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// tokenBucket is a token bucket rate limiter that additionally can be paused, e.g. due to a Retry-After header.
+// A rate of zero disables the rate limit, but still allows pausing.
+type tokenBucket struct {
+	sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	pausedUntil time.Time
+
+	now func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate > 0 && burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns the time to wait until the request may be sent
+func (tb *tokenBucket) reserve() time.Duration {
+	tb.Lock()
+	defer tb.Unlock()
+
+	now := tb.now()
+
+	var wait time.Duration
+	if tb.pausedUntil.After(now) {
+		wait = tb.pausedUntil.Sub(now)
+	}
+
+	if tb.rate <= 0 {
+		return wait
+	}
+
+	if !tb.last.IsZero() {
+		tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	}
+	tb.last = now
+	tb.tokens--
+
+	if tb.tokens < 0 {
+		wait = max(wait, time.Duration(-tb.tokens/tb.rate*float64(time.Second)))
+	}
+
+	return wait
+}
+
+// cancel returns a token taken by reserve that has not been used
+func (tb *tokenBucket) cancel() {
+	tb.Lock()
+	defer tb.Unlock()
+
+	if tb.rate > 0 {
+		tb.tokens = math.Min(tb.burst, tb.tokens+1)
+	}
+}
+
+// remainingPause returns the time until a pause of the bucket ends
+func (tb *tokenBucket) remainingPause() time.Duration {
+	tb.Lock()
+	defer tb.Unlock()
+
+	return tb.pausedUntil.Sub(tb.now())
+}
+
+// wait blocks until the request may be sent or the context is done. Pauses that start while
+// waiting, e.g. due to a throttled parallel request, are honoured as well.
+func (tb *tokenBucket) wait(ctx context.Context) error {
+	for wait := tb.reserve(); wait > 0; wait = tb.remainingPause() {
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			tb.cancel()
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// pauseUntil delays all requests that have not been sent yet until the given point in time
+func (tb *tokenBucket) pauseUntil(until time.Time) {
+	tb.Lock()
+	defer tb.Unlock()
+
+	if until.After(tb.pausedUntil) {
+		tb.pausedUntil = until
+	}
+}
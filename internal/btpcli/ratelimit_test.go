@@ -0,0 +1,248 @@
+package btpcli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/command/v2.106.1/security/role-collection", want: "security/role-collection"},
+		{path: "/command/v2.106.1/accounts/entitlement", want: "accounts/entitlement"},
+		{path: "/login/v2.106.1", want: ""},
+		{path: "/login/v2.106.1/idtoken", want: ""},
+		{path: "/command/v2.106.1", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.want, commandFromPath(test.path))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		value       string
+		wantWait    time.Duration
+		wantOk      bool
+	}{
+		{description: "seconds", value: "3", wantWait: 3 * time.Second, wantOk: true},
+		{description: "http date", value: "Mon, 01 Jan 2024 12:00:10 GMT", wantWait: 10 * time.Second, wantOk: true},
+		{description: "http date in the past", value: "Mon, 01 Jan 2024 11:00:00 GMT", wantWait: 0, wantOk: true},
+		{description: "empty", value: "", wantOk: false},
+		{description: "negative seconds", value: "-1", wantOk: false},
+		{description: "invalid", value: "soon", wantOk: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			wait, ok := parseRetryAfter(test.value, now)
+
+			assert.Equal(t, test.wantOk, ok)
+			assert.Equal(t, test.wantWait, wait)
+		})
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("burst is available immediately", func(t *testing.T) {
+		uut := newTokenBucket(2, 3)
+		uut.now = func() time.Time { return now }
+
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		assert.Equal(t, 500*time.Millisecond, uut.reserve())
+		assert.Equal(t, 1*time.Second, uut.reserve())
+	})
+
+	t.Run("tokens are refilled over time", func(t *testing.T) {
+		current := now
+		uut := newTokenBucket(2, 1)
+		uut.now = func() time.Time { return current }
+
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		current = current.Add(500 * time.Millisecond)
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		current = current.Add(10 * time.Second)
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		assert.Equal(t, 500*time.Millisecond, uut.reserve())
+	})
+
+	t.Run("burst defaults to rate", func(t *testing.T) {
+		uut := newTokenBucket(2.5, 0)
+
+		assert.Equal(t, float64(3), uut.burst)
+	})
+
+	t.Run("unlimited bucket honours pause", func(t *testing.T) {
+		uut := newTokenBucket(0, 0)
+		uut.now = func() time.Time { return now }
+
+		assert.Equal(t, time.Duration(0), uut.reserve())
+		uut.pauseUntil(now.Add(2 * time.Second))
+		assert.Equal(t, 2*time.Second, uut.reserve())
+		uut.pauseUntil(now.Add(1 * time.Second))
+		assert.Equal(t, 2*time.Second, uut.reserve())
+	})
+
+	t.Run("waiting is aborted by the context", func(t *testing.T) {
+		uut := newTokenBucket(0.01, 1)
+
+		assert.NoError(t, uut.wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, uut.wait(ctx), context.DeadlineExceeded)
+	})
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("most specific command prefix is selected", func(t *testing.T) {
+		uut := newRateLimitTransport(http.DefaultTransport, &RateLimitConfig{
+			CommandLimits: []CommandRateLimitConfig{
+				{CommandPrefix: "security/"},
+				{CommandPrefix: "security/role-collection"},
+				{CommandPrefix: "accounts/entitlement"},
+			},
+		})
+
+		limiters := uut.limitersFor("security/role-collection")
+		assert.Len(t, limiters, 2)
+		assert.Equal(t, "security/role-collection", limiters[0].prefix)
+		assert.Equal(t, uut.global, limiters[1])
+
+		limiters = uut.limitersFor("security/role")
+		assert.Len(t, limiters, 2)
+		assert.Equal(t, "security/", limiters[0].prefix)
+
+		assert.Equal(t, []*requestLimiter{uut.global}, uut.limitersFor("accounts/subaccount"))
+		assert.Equal(t, []*requestLimiter{uut.global}, uut.limitersFor(""))
+	})
+
+	t.Run("max concurrent requests are respected", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client := injectRateLimitTransport(srv.Client(), &RateLimitConfig{
+			MaxConcurrentRequests: 5,
+			CommandLimits: []CommandRateLimitConfig{
+				{CommandPrefix: "security/", MaxConcurrentRequests: 2},
+			},
+		})
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				res, err := client.Post(srv.URL+"/command/v2.106.1/security/role-collection?assign", "application/json", nil)
+				if assert.NoError(t, err) {
+					_ = res.Body.Close()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), maxInFlight.Load())
+	})
+
+	t.Run("retry after header pauses subsequent requests", func(t *testing.T) {
+		var attemptCount atomic.Int32
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attemptCount.Add(1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client := injectRateLimitTransport(srv.Client(), nil)
+
+		res, err := client.Get(srv.URL + "/command/v2.106.1/security/role-collection")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+
+		start := time.Now()
+		res, err = client.Get(srv.URL + "/command/v2.106.1/accounts/subaccount")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	})
+
+	t.Run("original client is left untouched", func(t *testing.T) {
+		original := &http.Client{}
+
+		limited := injectRateLimitTransport(original, nil)
+
+		assert.Nil(t, original.Transport)
+		assert.IsType(t, &rateLimitTransport{}, limited.Transport)
+	})
+}
+
+func TestV2Client_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var attemptCount atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount.Add(1)
+		w.Header().Set(HeaderCLIBackendStatus, "200")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	srvUrl, _ := url.Parse(srv.URL)
+	uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl, nil)
+	uut.UseRateLimit(&RateLimitConfig{RequestsPerSecond: 10, Burst: 1})
+	uut.newCorrelationID = func() string { return "test-cid" }
+
+	start := time.Now()
+	for range 3 {
+		_, err := uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(3), attemptCount.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond, "the second and third request must wait for a token")
+}
@@ -0,0 +1,115 @@
+package btpcli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/SAP/terraform-provider-btp/internal/sessioncache"
+)
+
+// UseSessionCache enables persisting sessions, so that subsequent provider invocations can reuse them instead of logging in again
+func (v2 *v2Client) UseSessionCache(cache *sessioncache.Cache) {
+	v2.sessionCache = cache
+}
+
+// cachedLogin restores the cached session of the given user if available. Otherwise, it runs the login flow
+// and caches the resulting session. Sessions of unknown users are not cached. The credential, e.g. the password,
+// is part of the cache key, so that a session is only reused if the same credential is passed.
+func (v2 *v2Client) cachedLogin(ctx context.Context, globalAccountSubdomain string, idp string, user string, credential string, login func(ctx context.Context) (*LoginResponse, error)) (*LoginResponse, error) {
+	if v2.sessionCache == nil || len(user) == 0 {
+		return login(ctx)
+	}
+
+	v2.sessionCacheKey = &sessioncache.Key{
+		ServerURL:              v2.serverURL.String(),
+		GlobalAccountSubdomain: globalAccountSubdomain,
+		IdentityProvider:       idp,
+		User:                   user,
+		Credential:             credential,
+	}
+
+	// an entry that cannot be read, e.g. due to a changed encryption key, is replaced by a new login
+	if entry, err := v2.sessionCache.Load(*v2.sessionCacheKey); err == nil && entry != nil {
+		v2.setSession(&Session{
+			GlobalAccountSubdomain: entry.GlobalAccountSubdomain,
+			IdentityProvider:       entry.IdentityProvider,
+			LoggedInUser: &v2LoggedInUser{
+				Email:  entry.Email,
+				Issuer: entry.Issuer,
+			},
+			SessionId: entry.SessionID,
+		})
+
+		return &LoginResponse{
+			Email:  entry.Email,
+			Issuer: entry.Issuer,
+		}, nil
+	}
+
+	loginResponse, err := login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v2.storeCachedSession()
+
+	return loginResponse, nil
+}
+
+// storeCachedSession persists the current session. As the cache only saves logins, failing to persist
+// the session is not an error.
+func (v2 *v2Client) storeCachedSession() {
+	if v2.sessionCache == nil || v2.sessionCacheKey == nil || v2.session == nil {
+		return
+	}
+
+	v2.session.Lock()
+	entry := sessioncache.Entry{
+		SessionID:              v2.session.SessionId,
+		GlobalAccountSubdomain: v2.session.GlobalAccountSubdomain,
+		IdentityProvider:       v2.session.IdentityProvider,
+	}
+	if v2.session.LoggedInUser != nil {
+		entry.Email = v2.session.LoggedInUser.Email
+		entry.Issuer = v2.session.LoggedInUser.Issuer
+	}
+	v2.session.Unlock()
+
+	_ = v2.sessionCache.Store(*v2.sessionCacheKey, entry)
+}
+
+// deleteCachedSession removes the current session from the cache, e.g. because it has expired on the server
+func (v2 *v2Client) deleteCachedSession() {
+	if v2.sessionCache == nil || v2.sessionCacheKey == nil {
+		return
+	}
+
+	_ = v2.sessionCache.Delete(*v2.sessionCacheKey)
+}
+
+// jwtSubject returns the issuer and subject of a JWT to identify the user of a token based login. The
+// signature is not verified, as the token is only used to look up a cached session of the same user.
+func jwtSubject(token string) string {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return ""
+	}
+
+	var claims struct {
+		Issuer  string `json:"iss"`
+		Subject string `json:"sub"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil || len(claims.Subject) == 0 {
+		return ""
+	}
+
+	return claims.Issuer + " " + claims.Subject
+}
@@ -0,0 +1,207 @@
+package btpcli
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SAP/terraform-provider-btp/internal/sessioncache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJwtSubject(t *testing.T) {
+	encode := func(payload string) string {
+		return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+
+	tests := []struct {
+		description string
+		token       string
+		want        string
+	}{
+		{description: "issuer and subject", token: encode(`{"iss":"https://token.actions.githubusercontent.com","sub":"repo:org/repo:ref:refs/heads/main"}`), want: "https://token.actions.githubusercontent.com repo:org/repo:ref:refs/heads/main"},
+		{description: "missing subject", token: encode(`{"iss":"https://token.actions.githubusercontent.com"}`), want: ""},
+		{description: "invalid payload", token: encode(`no json`), want: ""},
+		{description: "no jwt", token: "yourJWTtoken", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.want, jwtSubject(test.token))
+		})
+	}
+}
+
+func TestV2Client_SessionCache(t *testing.T) {
+	t.Parallel()
+
+	var loginCount atomic.Int32
+	var expiredSessions sync.Map
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/login") {
+			w.Header().Set(HeaderCLISessionId, fmt.Sprintf("session-%d", loginCount.Add(1)))
+			_, _ = fmt.Fprintf(w, `{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+			return
+		}
+
+		if _, expired := expiredSessions.Load(r.Header.Get(HeaderCLISessionId)); expired {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set(HeaderCLIBackendStatus, "200")
+		_, _ = fmt.Fprintf(w, "{}")
+	}))
+	defer srv.Close()
+
+	cache, err := sessioncache.New(t.TempDir(), "secret", time.Hour)
+	assert.NoError(t, err)
+
+	newClient := func() *v2Client {
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl, nil)
+		uut.UseSessionCache(cache)
+		return uut
+	}
+
+	// the first provider invocation logs in and caches the session
+	first := newClient()
+	_, err = first.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), loginCount.Load())
+
+	// the second provider invocation reuses the cached session
+	second := newClient()
+	res, err := second.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, &LoginResponse{Email: "john.doe@test.com", Issuer: "accounts.sap.com"}, res)
+	assert.Equal(t, int32(1), loginCount.Load())
+	assert.Equal(t, "session-1", second.session.SessionId)
+
+	// another user does not get the cached session
+	other := newClient()
+	_, err = other.Login(context.TODO(), NewLoginRequest("subdomain", "jane.doe", "pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), loginCount.Load())
+
+	// the same user with another password does not get the cached session
+	changedPassword := newClient()
+	_, err = changedPassword.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "other pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), loginCount.Load())
+	assert.Equal(t, "session-3", changedPassword.session.SessionId)
+
+	// an expired session is replaced in the cache
+	expiredSessions.Store("session-1", true)
+	_, err = second.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), loginCount.Load())
+	assert.Equal(t, "session-4", second.session.SessionId)
+
+	entry, err := cache.Load(*second.sessionCacheKey)
+	if assert.NoError(t, err) && assert.NotNil(t, entry) {
+		assert.Equal(t, "session-4", entry.SessionID)
+	}
+
+	third := newClient()
+	_, err = third.Login(context.TODO(), NewLoginRequest("subdomain", "john.doe", "pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), loginCount.Load())
+	assert.Equal(t, "session-4", third.session.SessionId)
+}
+
+func TestV2Client_SessionCache_IdToken(t *testing.T) {
+	t.Parallel()
+
+	var loginCount atomic.Int32
+	var expiredSessions sync.Map
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/login") {
+			assert.True(t, strings.HasSuffix(r.URL.Path, "/idtoken"))
+			w.Header().Set(HeaderCLISessionId, fmt.Sprintf("session-%d", loginCount.Add(1)))
+			_, _ = fmt.Fprintf(w, `{"issuer": "accounts.sap.com","user":"john.doe","mail":"john.doe@test.com"}`)
+			return
+		}
+
+		if _, expired := expiredSessions.Load(r.Header.Get(HeaderCLISessionId)); expired {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set(HeaderCLIBackendStatus, "200")
+		_, _ = fmt.Fprintf(w, "{}")
+	}))
+	defer srv.Close()
+
+	cache, err := sessioncache.New(t.TempDir(), "secret", time.Hour)
+	assert.NoError(t, err)
+
+	newClient := func() *v2Client {
+		srvUrl, _ := url.Parse(srv.URL)
+		uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl, nil)
+		uut.UseSessionCache(cache)
+		return uut
+	}
+
+	idToken := "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://token.actions.githubusercontent.com","sub":"repo:org/repo:ref:refs/heads/main"}`)) + ".signature"
+
+	first := newClient()
+	_, err = first.IdTokenLogin(context.TODO(), NewIdTokenLoginRequest("subdomain", idToken))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), loginCount.Load())
+
+	// the cached session is restored and renewed with the id token once it has expired
+	second := newClient()
+	_, err = second.IdTokenLogin(context.TODO(), NewIdTokenLoginRequest("subdomain", idToken))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), loginCount.Load())
+
+	expiredSessions.Store("session-1", true)
+	_, err = second.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), loginCount.Load())
+	assert.Equal(t, "session-2", second.session.SessionId)
+
+	entry, err := cache.Load(*second.sessionCacheKey)
+	if assert.NoError(t, err) && assert.NotNil(t, entry) {
+		assert.Equal(t, "session-2", entry.SessionID)
+	}
+}
+
+func TestV2Client_SessionCache_NotRenewable(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	cache, err := sessioncache.New(t.TempDir(), "secret", time.Hour)
+	assert.NoError(t, err)
+
+	key := sessioncache.Key{ServerURL: srv.URL, GlobalAccountSubdomain: "subdomain", User: "john.doe"}
+	assert.NoError(t, cache.Store(key, sessioncache.Entry{SessionID: "expired"}))
+
+	srvUrl, _ := url.Parse(srv.URL)
+	uut := NewV2ClientWithHttpClient(srv.Client(), srvUrl, nil)
+	uut.UseSessionCache(cache)
+	uut.session = &Session{SessionId: "expired"}
+	uut.sessionCacheKey = &key
+
+	_, err = uut.Execute(context.TODO(), NewGetRequest("accounts/subaccount", map[string]string{}))
+	assert.Error(t, err)
+
+	entry, err := cache.Load(key)
+	assert.NoError(t, err)
+	assert.Nil(t, entry, "the expired session must be removed from the cache")
+}
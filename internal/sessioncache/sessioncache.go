@@ -0,0 +1,344 @@
+package sessioncache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTTL = 8 * time.Hour
+
+	saltSize         = 16
+	keySize          = 32
+	pbkdf2Iterations = 600_000
+
+	saltFileName = "salt"
+	hkdfInfo     = "terraform-provider-btp session cache"
+)
+
+// masterKeys holds the keys derived from a passphrase and the salt of a cache directory, so that the
+// expensive key derivation only runs once per process
+var masterKeys sync.Map
+
+// Key identifies a cached session. Sessions are only shared between provider
+// invocations that target the same CLI server, global account, IdP and user and
+// that log in with the same credential. The key is never persisted, entries are
+// named by an HMAC of the key using the master key of the cache.
+type Key struct {
+	ServerURL              string
+	GlobalAccountSubdomain string
+	IdentityProvider       string
+	User                   string
+	Credential             string
+}
+
+// Entry is the persisted information of a session.
+type Entry struct {
+	SessionID              string    `json:"sessionId"`
+	GlobalAccountSubdomain string    `json:"globalAccountSubdomain"`
+	IdentityProvider       string    `json:"identityProvider"`
+	Email                  string    `json:"email"`
+	Issuer                 string    `json:"issuer"`
+	ExpiresAt              time.Time `json:"expiresAt"`
+}
+
+// Cache stores sessions encrypted on disk. Every entry is encrypted with AES-GCM
+// using a key derived from the master key of the cache and a random salt per entry.
+// The master key is derived from the passphrase and the salt of the cache directory.
+type Cache struct {
+	directory  string
+	passphrase string
+	ttl        time.Duration
+
+	now func() time.Time
+}
+
+// New returns a cache that stores the sessions in the given directory (pass "" for
+// the default location) for the given time to live.
+func New(directory string, passphrase string, ttl time.Duration) (*Cache, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("the encryption key of the session cache must not be empty")
+	}
+
+	if ttl <= 0 {
+		return nil, fmt.Errorf("the time to live of the session cache must be positive, got: %s", ttl)
+	}
+
+	if directory == "" {
+		var err error
+		if directory, err = DefaultDirectory(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Cache{
+		directory:  directory,
+		passphrase: passphrase,
+		ttl:        ttl,
+		now:        time.Now,
+	}, nil
+}
+
+// DefaultDirectory returns the directory in the user cache directory where sessions are stored by default.
+func DefaultDirectory() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", errors.New("cannot determine user cache directory")
+	}
+	return filepath.Join(cacheDir, "terraform-provider-btp", "sessions"), nil
+}
+
+// Load returns the cached session for the given key. If no session is cached or the cached
+// session has expired, nil is returned.
+func (c *Cache) Load(key Key) (*Entry, error) {
+	id, err := c.idOf(key)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(c.directory, id)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read cached session: %w", err)
+	}
+
+	plaintext, err := c.decrypt(data, id)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt cached session: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(plaintext, &entry); err != nil {
+		return nil, fmt.Errorf("parse cached session: %w", err)
+	}
+
+	if !entry.ExpiresAt.After(c.now()) {
+		_ = os.Remove(path)
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+// Store persists the session for the given key. The expiry of the entry is set according to
+// the time to live of the cache.
+func (c *Cache) Store(key Key, entry Entry) error {
+	entry.ExpiresAt = c.now().Add(c.ttl)
+
+	id, err := c.idOf(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.encrypt(plaintext, id)
+	if err != nil {
+		return fmt.Errorf("encrypt session: %w", err)
+	}
+
+	if err := os.MkdirAll(c.directory, 0700); err != nil {
+		return fmt.Errorf("create session cache directory: %w", err)
+	}
+
+	// write to a temporary file first, so parallel provider invocations never read a partial entry
+	tmpFile, err := os.CreateTemp(c.directory, "session-*.tmp")
+	if err != nil {
+		return fmt.Errorf("write cached session: %w", err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("write cached session: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("write cached session: %w", err)
+	}
+
+	if err := os.Rename(tmpFile.Name(), filepath.Join(c.directory, id)); err != nil {
+		return fmt.Errorf("write cached session: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the cached session for the given key, e.g. because the session has expired on the server.
+func (c *Cache) Delete(key Key) error {
+	id, err := c.idOf(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filepath.Join(c.directory, id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete cached session: %w", err)
+	}
+	return nil
+}
+
+// idOf returns the name of the entry for the given key. It is an HMAC keyed by the master key, so neither the
+// key nor the credential can be guessed from the name without the passphrase.
+func (c *Cache) idOf(key Key) (string, error) {
+	masterKey, err := c.masterKey()
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, masterKey)
+	mac.Write([]byte(strings.Join([]string{key.ServerURL, key.GlobalAccountSubdomain, key.IdentityProvider, key.User, key.Credential}, "\x00")))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// masterKey derives the master key from the passphrase and the salt of the cache directory. The salt
+// is created with the first entry of the cache.
+func (c *Cache) masterKey() ([]byte, error) {
+	salt, err := c.directorySalt()
+	if err != nil {
+		return nil, err
+	}
+
+	id := sha256.Sum256(append([]byte(c.passphrase+"\x00"), salt...))
+	if masterKey, ok := masterKeys.Load(id); ok {
+		return masterKey.([]byte), nil
+	}
+
+	masterKey, err := pbkdf2.Key(sha256.New, c.passphrase, salt, pbkdf2Iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKeys.Store(id, masterKey)
+
+	return masterKey, nil
+}
+
+// directorySalt returns the salt of the cache directory and creates it if it does not exist yet
+func (c *Cache) directorySalt() ([]byte, error) {
+	saltPath := filepath.Join(c.directory, saltFileName)
+
+	salt, err := os.ReadFile(saltPath)
+	if err == nil && len(salt) == saltSize {
+		return salt, nil
+	}
+
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read salt of session cache: %w", err)
+	}
+
+	if err := os.MkdirAll(c.directory, 0700); err != nil {
+		return nil, fmt.Errorf("create session cache directory: %w", err)
+	}
+
+	salt = make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	tmpFile, err := os.CreateTemp(c.directory, "salt-*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("write salt of session cache: %w", err)
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(salt); err != nil {
+		_ = tmpFile.Close()
+		return nil, fmt.Errorf("write salt of session cache: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return nil, fmt.Errorf("write salt of session cache: %w", err)
+	}
+
+	// a parallel provider invocation might have created the salt in the meantime, in this case its salt is used
+	if err := os.Link(tmpFile.Name(), saltPath); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("write salt of session cache: %w", err)
+	}
+
+	if salt, err = os.ReadFile(saltPath); err != nil || len(salt) != saltSize {
+		return nil, errors.New("invalid salt of session cache")
+	}
+
+	return salt, nil
+}
+
+// newAEAD derives the encryption key from the master key and the given salt
+func (c *Cache) newAEAD(salt []byte) (cipher.AEAD, error) {
+	masterKey, err := c.masterKey()
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := hkdf.Key(sha256.New, masterKey, salt, hkdfInfo, keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encrypt returns salt, nonce and ciphertext. The id of the entry is used as additional data, so an
+// entry cannot be used for another key.
+func (c *Cache) encrypt(plaintext []byte, id string) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := c.newAEAD(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	data := append(salt, nonce...)
+	return aead.Seal(data, nonce, plaintext, []byte(id)), nil
+}
+
+func (c *Cache) decrypt(data []byte, id string) ([]byte, error) {
+	if len(data) < saltSize {
+		return nil, errors.New("invalid cache entry")
+	}
+
+	aead, err := c.newAEAD(data[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	data = data[saltSize:]
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("invalid cache entry")
+	}
+
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(id))
+}
@@ -0,0 +1,199 @@
+package sessioncache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = Key{
+	ServerURL:              "https://cli.btp.cloud.sap",
+	GlobalAccountSubdomain: "my-ga",
+	IdentityProvider:       "my.custom.idp",
+	User:                   "john.doe@test.com",
+}
+
+var testEntry = Entry{
+	SessionID:              "session-id",
+	GlobalAccountSubdomain: "my-ga",
+	IdentityProvider:       "my.custom.idp",
+	Email:                  "john.doe@test.com",
+	Issuer:                 "my.custom.idp",
+}
+
+func TestNew(t *testing.T) {
+	t.Run("empty passphrase", func(t *testing.T) {
+		_, err := New(t.TempDir(), "", DefaultTTL)
+
+		assert.EqualError(t, err, "the encryption key of the session cache must not be empty")
+	})
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := New(t.TempDir(), "secret", 0)
+
+		assert.EqualError(t, err, "the time to live of the session cache must be positive, got: 0s")
+	})
+	t.Run("default directory", func(t *testing.T) {
+		uut, err := New("", "secret", DefaultTTL)
+
+		if assert.NoError(t, err) {
+			defaultDir, _ := DefaultDirectory()
+			assert.Equal(t, defaultDir, uut.directory)
+		}
+	})
+}
+
+func TestCache_StoreAndLoad(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	newCache := func(t *testing.T, directory string, passphrase string) *Cache {
+		uut, err := New(directory, passphrase, time.Hour)
+		assert.NoError(t, err)
+		uut.now = func() time.Time { return now }
+		return uut
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		uut := newCache(t, t.TempDir(), "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		entry, err := uut.Load(testKey)
+
+		assert.NoError(t, err)
+		expected := testEntry
+		expected.ExpiresAt = now.Add(time.Hour)
+		assert.Equal(t, &expected, entry)
+	})
+	t.Run("entry is encrypted", func(t *testing.T) {
+		directory := t.TempDir()
+		uut := newCache(t, directory, "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		id, err := uut.idOf(testKey)
+		assert.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(directory, id))
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), testEntry.SessionID)
+
+		info, err := os.Stat(filepath.Join(directory, id))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+	t.Run("missing entry", func(t *testing.T) {
+		uut := newCache(t, t.TempDir(), "secret")
+
+		entry, err := uut.Load(testKey)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("other user", func(t *testing.T) {
+		uut := newCache(t, t.TempDir(), "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		otherKey := testKey
+		otherKey.User = "jane.doe@test.com"
+		entry, err := uut.Load(otherKey)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("other credential", func(t *testing.T) {
+		uut := newCache(t, t.TempDir(), "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		otherKey := testKey
+		otherKey.Credential = "other-pass"
+		entry, err := uut.Load(otherKey)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("salt is shared by the caches of a directory", func(t *testing.T) {
+		directory := t.TempDir()
+
+		assert.NoError(t, newCache(t, directory, "secret").Store(testKey, testEntry))
+
+		salt, err := os.ReadFile(filepath.Join(directory, saltFileName))
+		assert.NoError(t, err)
+		assert.Len(t, salt, saltSize)
+
+		entry, err := newCache(t, directory, "secret").Load(testKey)
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+
+		saltAfterLoad, err := os.ReadFile(filepath.Join(directory, saltFileName))
+		assert.NoError(t, err)
+		assert.Equal(t, salt, saltAfterLoad)
+	})
+	t.Run("wrong passphrase", func(t *testing.T) {
+		directory := t.TempDir()
+
+		assert.NoError(t, newCache(t, directory, "secret").Store(testKey, testEntry))
+
+		entry, err := newCache(t, directory, "other secret").Load(testKey)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("entry name depends on the master key", func(t *testing.T) {
+		id, err := newCache(t, t.TempDir(), "secret").idOf(testKey)
+		assert.NoError(t, err)
+
+		otherId, err := newCache(t, t.TempDir(), "secret").idOf(testKey)
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, id, otherId, "the salt of the cache directory must be part of the entry name")
+	})
+	t.Run("entry copied to another key", func(t *testing.T) {
+		directory := t.TempDir()
+		uut := newCache(t, directory, "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		otherKey := testKey
+		otherKey.GlobalAccountSubdomain = "other-ga"
+		id, _ := uut.idOf(testKey)
+		otherId, _ := uut.idOf(otherKey)
+		data, _ := os.ReadFile(filepath.Join(directory, id))
+		assert.NoError(t, os.WriteFile(filepath.Join(directory, otherId), data, 0600))
+
+		_, err := uut.Load(otherKey)
+
+		assert.ErrorContains(t, err, "decrypt cached session")
+	})
+	t.Run("expired entry", func(t *testing.T) {
+		directory := t.TempDir()
+		uut := newCache(t, directory, "secret")
+
+		assert.NoError(t, uut.Store(testKey, testEntry))
+
+		uut.now = func() time.Time { return now.Add(time.Hour) }
+		entry, err := uut.Load(testKey)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+		id, _ := uut.idOf(testKey)
+		assert.NoFileExists(t, filepath.Join(directory, id))
+	})
+}
+
+func TestCache_Delete(t *testing.T) {
+	uut, _ := New(t.TempDir(), "secret", time.Hour)
+
+	assert.NoError(t, uut.Store(testKey, testEntry))
+	assert.NoError(t, uut.Delete(testKey))
+
+	entry, err := uut.Load(testKey)
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	assert.NoError(t, uut.Delete(testKey), "deleting a missing entry must not fail")
+}
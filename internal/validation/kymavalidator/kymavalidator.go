@@ -0,0 +1,159 @@
+package kymavalidator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MachineTypes contains the machine types that are known to be available for the worker nodes per Kyma plan. The environment
+// broker may offer further machine types, so unknown machine types only result in a warning.
+var MachineTypes = map[string][]string{
+	"aws": {
+		"m6i.large", "m6i.xlarge", "m6i.2xlarge", "m6i.4xlarge", "m6i.8xlarge", "m6i.12xlarge",
+		"m5.large", "m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge",
+	},
+	"azure": {
+		"Standard_D2s_v5", "Standard_D4s_v5", "Standard_D8s_v5", "Standard_D16s_v5", "Standard_D32s_v5", "Standard_D48s_v5", "Standard_D64s_v5",
+		"Standard_D4_v3", "Standard_D8_v3", "Standard_D16_v3", "Standard_D32_v3", "Standard_D48_v3", "Standard_D64_v3",
+	},
+	"azure_lite": {
+		"Standard_D2s_v5", "Standard_D4s_v5", "Standard_D4_v3",
+	},
+	"gcp": {
+		"n2-standard-2", "n2-standard-4", "n2-standard-8", "n2-standard-16", "n2-standard-32", "n2-standard-48",
+	},
+	"sap-converged-cloud": {
+		"g_c2_m8", "g_c4_m16", "g_c6_m24", "g_c8_m32", "g_c12_m48", "g_c16_m64", "g_c32_m128", "g_c64_m256",
+	},
+}
+
+// AutoscalerRanges contains the minimum and maximum number of worker nodes that are known to be supported per Kyma plan. The
+// environment broker may extend the ranges, so values outside of them only result in a warning.
+var AutoscalerRanges = map[string][2]int64{
+	"aws":                 {3, 300},
+	"azure":               {3, 300},
+	"azure_lite":          {2, 40},
+	"gcp":                 {3, 300},
+	"sap-converged-cloud": {3, 300},
+}
+
+type machineTypeValidator struct {
+	planExpr path.Expression
+}
+
+func (v machineTypeValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v machineTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value should be a machine type that is known to be available for the Kyma plan"
+}
+
+func (v machineTypeValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	plan, ok := planFromConfig(ctx, request.Config, v.planExpr, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// plans without a known set of machine types are validated by the environment broker
+	allowed, ok := MachineTypes[plan]
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(allowed, request.ConfigValue.ValueString()) {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("Attribute %s value %q is not one of the known machine types [%s] for plan %q. The environment broker rejects the value if the machine type is not available.", request.Path, request.ConfigValue.ValueString(), strings.Join(allowed, ", "), plan),
+		)
+	}
+}
+
+// ValidMachineType warns if the machine type is not known to be available for the Kyma plan found at the given path
+func ValidMachineType(planExpr path.Expression) validator.String {
+	return machineTypeValidator{
+		planExpr: planExpr,
+	}
+}
+
+type autoscalerValidator struct {
+	planExpr path.Expression
+}
+
+func (v autoscalerValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v autoscalerValidator) MarkdownDescription(ctx context.Context) string {
+	return "value should be within the number of worker nodes that is known to be supported by the Kyma plan"
+}
+
+func (v autoscalerValidator) ValidateInt64(ctx context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	plan, ok := planFromConfig(ctx, request.Config, v.planExpr, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	bounds, ok := AutoscalerRanges[plan]
+	if !ok {
+		return
+	}
+
+	if value := request.ConfigValue.ValueInt64(); value < bounds[0] || value > bounds[1] {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("Attribute %s value %d is not within the known range of %d to %d worker nodes for plan %q. The environment broker rejects the value if it is not supported.", request.Path, value, bounds[0], bounds[1], plan),
+		)
+	}
+}
+
+// ValidAutoscalerValue warns if the number of worker nodes is not known to be supported by the Kyma plan found at the given path
+func ValidAutoscalerValue(planExpr path.Expression) validator.Int64 {
+	return autoscalerValidator{
+		planExpr: planExpr,
+	}
+}
+
+// planFromConfig returns the name of the Kyma plan, false is returned if the plan is not known yet
+func planFromConfig(ctx context.Context, config tfsdk.Config, planExpr path.Expression, diagnostics *diag.Diagnostics) (string, bool) {
+	// get the path for attribute plan from the expression
+	planPath, diags := config.PathMatches(ctx, planExpr)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return "", false
+	}
+
+	// get the value of the attribute plan from the path
+	var planVal attr.Value
+	diags = config.GetAttribute(ctx, planPath[0], &planVal)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return "", false
+	}
+
+	val, ok := planVal.(types.String)
+	if !ok || val.IsNull() || val.IsUnknown() {
+		return "", false
+	}
+
+	return val.ValueString(), true
+}
@@ -0,0 +1,160 @@
+package kymavalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"plan_name": schema.StringAttribute{},
+	},
+}
+
+func testConfig(plan string) tfsdk.Config {
+	return tfsdk.Config{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"plan_name": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"plan_name": tftypes.NewValue(tftypes.String, plan),
+			},
+		),
+	}
+}
+
+func TestMachineTypeValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan        string
+		machineType string
+		planExpr    path.Expression
+		expErrors   int
+		expWarnings int
+	}
+
+	testCases := map[string]testCase{
+		"valid-machine-type": {
+			plan:        "aws",
+			machineType: "m6i.large",
+			planExpr:    path.MatchRoot("plan_name"),
+			expErrors:   0,
+		},
+		"machine-type-of-other-plan": {
+			plan:        "aws",
+			machineType: "Standard_D4s_v5",
+			planExpr:    path.MatchRoot("plan_name"),
+			expErrors:   0,
+			expWarnings: 1,
+		},
+		"plan-without-machine-types": {
+			plan:        "trial",
+			machineType: "anything",
+			planExpr:    path.MatchRoot("plan_name"),
+			expErrors:   0,
+		},
+		"incorrect-attribute-path": {
+			plan:        "aws",
+			machineType: "m6i.large",
+			planExpr:    path.MatchRoot("incorrect_attribute"),
+			expErrors:   1,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			v := machineTypeValidator{
+				planExpr: test.planExpr,
+			}
+
+			req := validator.StringRequest{
+				Path:        path.Root("machine_type"),
+				Config:      testConfig(test.plan),
+				ConfigValue: types.StringValue(test.machineType),
+			}
+
+			res := validator.StringResponse{}
+
+			v.ValidateString(context.TODO(), req, &res)
+
+			if res.Diagnostics.ErrorsCount() != test.expErrors {
+				t.Fatalf("expected %d error(s), got %d: %v", test.expErrors, res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+
+			if res.Diagnostics.WarningsCount() != test.expWarnings {
+				t.Fatalf("expected %d warning(s), got %d: %v", test.expWarnings, res.Diagnostics.WarningsCount(), res.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAutoscalerValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan        string
+		value       int64
+		expWarnings int
+	}
+
+	testCases := map[string]testCase{
+		"within-range": {
+			plan:        "gcp",
+			value:       3,
+			expWarnings: 0,
+		},
+		"below-range": {
+			plan:        "gcp",
+			value:       2,
+			expWarnings: 1,
+		},
+		"above-range": {
+			plan:        "azure_lite",
+			value:       41,
+			expWarnings: 1,
+		},
+		"plan-without-range": {
+			plan:        "trial",
+			value:       1,
+			expWarnings: 0,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			v := autoscalerValidator{
+				planExpr: path.MatchRoot("plan_name"),
+			}
+
+			req := validator.Int64Request{
+				Path:        path.Root("autoscaler_min"),
+				Config:      testConfig(test.plan),
+				ConfigValue: types.Int64Value(test.value),
+			}
+
+			res := validator.Int64Response{}
+
+			v.ValidateInt64(context.TODO(), req, &res)
+
+			if res.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got: %v", res.Diagnostics)
+			}
+
+			if res.Diagnostics.WarningsCount() != test.expWarnings {
+				t.Fatalf("expected %d warning(s), got %d: %v", test.expWarnings, res.Diagnostics.WarningsCount(), res.Diagnostics)
+			}
+		})
+	}
+}